@@ -0,0 +1,312 @@
+// client.go downloads puzzle inputs from the Advent of Code site and submits answers to it
+// on behalf of a logged in user
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultBaseURL is the address of the Advent of Code site
+	DefaultBaseURL = "https://adventofcode.com"
+
+	// DefaultInterval is the minimum time between the requests made by a Client. The site
+	// asks that automated requests are throttled, so a Client never makes them back to back.
+	DefaultInterval = 5 * time.Second
+
+	// SessionEnv is the environment variable that holds the session token by default
+	SessionEnv = "AOC_SESSION"
+
+	// userAgent identifies the requests made by this repository, as the site asks
+	userAgent = "github.com/trentnix/aoc2024"
+)
+
+// ErrNoSession is returned when a request needs a session token but the Client doesn't have one
+var ErrNoSession = errors.New("a session token is required: log in to the Advent of Code site and copy the value of the session cookie into $" + SessionEnv)
+
+// ErrNotAvailable is returned when the input of a day is requested before the puzzle unlocks
+var ErrNotAvailable = errors.New("the puzzle isn't available yet")
+
+// RateLimitError is returned when a request can't be made until the rate limit has passed.
+// It is returned without contacting the site if a previous response said to wait.
+type RateLimitError struct {
+	Wait time.Duration // how much longer to wait before trying again
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("answers are being submitted too quickly: wait %v before trying again", e.Wait.Round(time.Second))
+}
+
+// Client makes requests to the Advent of Code site. The exported fields may be changed
+// before the first request is made; the zero value of each is replaced with its default.
+type Client struct {
+	BaseURL    string        // the address of the site; DefaultBaseURL if empty
+	Session    string        // the value of the session cookie of the logged in user
+	DataDir    string        // the directory inputs are cached in as YYYY/dayN/input.txt; "data" if empty
+	Interval   time.Duration // the minimum time between requests; DefaultInterval if 0
+	HTTPClient *http.Client  // the client the requests are made with; http.DefaultClient if nil
+
+	mu          sync.Mutex
+	last        time.Time // when the last request was made
+	submitAfter time.Time // when the site will next accept an answer
+}
+
+// NewClient returns a Client for the site with the specified session token. If the token is
+// empty, it is read from $AOC_SESSION.
+func NewClient(session string) *Client {
+	if session == "" {
+		session = strings.TrimSpace(os.Getenv(SessionEnv))
+	}
+
+	return &Client{Session: session}
+}
+
+// InputFile returns the name of the file the input of the specified day is cached in
+func (c *Client) InputFile(year, day int) string {
+	dataDir := c.DataDir
+	if dataDir == "" {
+		dataDir = "data"
+	}
+
+	return filepath.Join(dataDir, strconv.Itoa(year), fmt.Sprintf("day%d", day), "input.txt")
+}
+
+// HistoryFile returns the name of the file the answers submitted for the specified day are
+// logged in
+func (c *Client) HistoryFile(year, day int) string {
+	return filepath.Join(filepath.Dir(c.InputFile(year, day)), HistoryFileName)
+}
+
+// History returns the log of the answers submitted for the specified day
+func (c *Client) History(year, day int) (History, error) {
+	return LoadHistory(c.HistoryFile(year, day))
+}
+
+// Input returns the input of the specified day. The input is read from the cache if it has
+// already been downloaded, otherwise it is downloaded and cached. An empty cached file
+// (such as the one created for a new day) is treated as missing.
+func (c *Client) Input(ctx context.Context, year, day int) ([]byte, error) {
+	filename := c.InputFile(year, day)
+
+	if data, err := os.ReadFile(filename); err == nil && len(data) > 0 {
+		return data, nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+
+	data, err := c.download(ctx, year, day)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return nil, err
+	}
+
+	if err := os.WriteFile(filename, data, 0o644); err != nil {
+		return nil, fmt.Errorf("there was an error trying to cache the input in %s: %w", filename, err)
+	}
+
+	return data, nil
+}
+
+// download requests the input of the specified day from the site
+func (c *Client) download(ctx context.Context, year, day int) ([]byte, error) {
+	request, err := c.newRequest(ctx, http.MethodGet, fmt.Sprintf("/%d/day/%d/input", year, day), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := c.do(request)
+	if err != nil {
+		return nil, fmt.Errorf("there was an error trying to download the input of day %d of %d: %w", day, year, err)
+	}
+	defer response.Body.Close()
+
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("there was an error trying to download the input of day %d of %d: %w", day, year, err)
+	}
+
+	switch {
+	case response.StatusCode == http.StatusNotFound:
+		return nil, fmt.Errorf("day %d of %d: %w", day, year, ErrNotAvailable)
+	case response.StatusCode == http.StatusBadRequest || response.StatusCode == http.StatusUnauthorized:
+		return nil, fmt.Errorf("the site rejected the session token (%s): it may have expired", response.Status)
+	case response.StatusCode != http.StatusOK:
+		return nil, fmt.Errorf("the site responded to the request for the input of day %d of %d with %s", day, year, response.Status)
+	}
+
+	return data, nil
+}
+
+// Submit submits the answer to the specified part of a day and returns the site's verdict.
+// The verdict is logged in the History of the day. If the site said to wait before
+// submitting again, the wait is logged too, and a *RateLimitError is returned (without
+// contacting the site) until the wait has passed, even by a Client created later.
+//
+// The answer is submitted even if the History shows it is wrong; use History.Check first to
+// avoid that.
+func (c *Client) Submit(ctx context.Context, year, day, part int, answer string) (Verdict, error) {
+	if part != 1 && part != 2 {
+		return Verdict{}, fmt.Errorf("invalid part %d: expected 1 or 2", part)
+	}
+
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return Verdict{}, fmt.Errorf("the answer to part %d is empty", part)
+	}
+
+	// the wait is logged in the history as well, since a wait asked for in a previous run
+	// (by another Client) has to be honoured too
+	history, err := c.History(year, day)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("there was an error trying to read the log of the answers: %w", err)
+	}
+
+	c.mu.Lock()
+	wait := max(time.Until(c.submitAfter), history.Wait(time.Now()))
+	c.mu.Unlock()
+
+	if wait > 0 {
+		return Verdict{}, &RateLimitError{Wait: wait}
+	}
+
+	form := url.Values{"level": {strconv.Itoa(part)}, "answer": {answer}}
+	request, err := c.newRequest(ctx, http.MethodPost, fmt.Sprintf("/%d/day/%d/answer", year, day), strings.NewReader(form.Encode()))
+	if err != nil {
+		return Verdict{}, err
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	response, err := c.do(request)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("there was an error trying to submit the answer to day %d of %d: %w", day, year, err)
+	}
+	defer response.Body.Close()
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return Verdict{}, fmt.Errorf("there was an error trying to read the response to the answer to day %d of %d: %w", day, year, err)
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return Verdict{}, fmt.Errorf("the site responded to the answer to day %d of %d with %s", day, year, response.Status)
+	}
+
+	verdict := ParseVerdict(string(body))
+	now := time.Now()
+	if verdict.Wait > 0 {
+		c.mu.Lock()
+		c.submitAfter = now.Add(verdict.Wait)
+		c.mu.Unlock()
+
+		history.SetWait(verdict.Wait, now)
+	}
+
+	history.Add(part, answer, verdict, now)
+	if err := SaveHistory(c.HistoryFile(year, day), history); err != nil {
+		return verdict, fmt.Errorf("there was an error trying to log the verdict: %w", err)
+	}
+
+	if verdict.Outcome == RateLimited {
+		return verdict, &RateLimitError{Wait: verdict.Wait}
+	}
+
+	return verdict, nil
+}
+
+// newRequest returns a request for the path of the site with the session cookie and the
+// user agent set
+func (c *Client) newRequest(ctx context.Context, method, path string, body io.Reader) (*http.Request, error) {
+	if c.Session == "" {
+		return nil, ErrNoSession
+	}
+
+	baseURL := c.BaseURL
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+
+	request, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(baseURL, "/")+path, body)
+	if err != nil {
+		return nil, err
+	}
+
+	request.AddCookie(&http.Cookie{Name: "session", Value: c.Session})
+	request.Header.Set("User-Agent", userAgent)
+
+	return request, nil
+}
+
+// do makes the request once at least Interval has passed since the previous request. A
+// response with the status 429 (Too Many Requests) is returned as a *RateLimitError.
+func (c *Client) do(request *http.Request) (*http.Response, error) {
+	if err := c.throttle(request.Context()); err != nil {
+		return nil, err
+	}
+
+	httpClient := c.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.StatusCode == http.StatusTooManyRequests {
+		response.Body.Close()
+
+		wait := c.interval()
+		if seconds, err := strconv.Atoi(response.Header.Get("Retry-After")); err == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+
+		return nil, &RateLimitError{Wait: wait}
+	}
+
+	return response, nil
+}
+
+// throttle waits until Interval has passed since the previous request, or until the
+// context is done
+func (c *Client) throttle(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if wait := time.Until(c.last.Add(c.interval())); wait > 0 {
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	c.last = time.Now()
+	return nil
+}
+
+// interval returns the minimum time between requests
+func (c *Client) interval() time.Duration {
+	if c.Interval == 0 {
+		return DefaultInterval
+	}
+
+	return c.Interval
+}
@@ -0,0 +1,304 @@
+package aoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const testSession = "0123456789abcdef"
+
+// fakeSite is a stand-in for the Advent of Code site. The input of day 1 of 2024 is
+// available, later days aren't, and answers are judged against the answers map.
+type fakeSite struct {
+	requests atomic.Int32
+	answers  map[string]string // the response page for each submitted answer
+}
+
+func (s *fakeSite) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.requests.Add(1)
+
+	if cookie, err := r.Cookie("session"); err != nil || cookie.Value != testSession {
+		http.Error(w, "Puzzle inputs differ by user.  Please log in to get your puzzle input.", http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/2024/day/1/input":
+		fmt.Fprint(w, "3   4\n4   3\n")
+	case r.Method == http.MethodGet:
+		http.NotFound(w, r)
+	case r.Method == http.MethodPost && r.URL.Path == "/2024/day/1/answer":
+		if r.FormValue("level") != "1" {
+			http.Error(w, "unexpected level", http.StatusBadRequest)
+			return
+		}
+
+		page, found := s.answers[r.FormValue("answer")]
+		if !found {
+			page = wrongPage
+		}
+
+		fmt.Fprintf(w, "<html><body><main>%s</main></body></html>", page)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+const (
+	rightPage    = `<article><p>That's the right answer!  You are <span class="day-success">one gold star</span> closer to finding the Chief Historian. <a href="/2024/day/1#part2">[Continue to Part Two]</a></p></article>`
+	wrongPage    = `<article><p>That's not the right answer.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/1">[Return to Day 1]</a></p></article>`
+	tooHighPage  = `<article><p>That's not the right answer; your answer is too high.  If you're stuck, make sure you're using the full input data. Please wait one minute before trying again. <a href="/2024/day/1">[Return to Day 1]</a></p></article>`
+	tooLowPage   = `<article><p>That's not the right answer; your answer is too low.  Please wait 5 minutes before trying again. <a href="/2024/day/1">[Return to Day 1]</a></p></article>`
+	tooSoonPage  = `<article><p>You gave an answer too recently; you have to wait after submitting an answer before trying again.  You have 1m 5s left to wait. <a href="/2024/day/1">[Return to Day 1]</a></p></article>`
+	completePage = `<article><p>You don't seem to be solving the right level.  Did you already complete it? <a href="/2024/day/1">[Return to Day 1]</a></p></article>`
+)
+
+// newTestClient returns a client of a fake site that caches inputs in a temporary directory
+func newTestClient(t *testing.T, site *fakeSite) *Client {
+	server := httptest.NewServer(site)
+	t.Cleanup(server.Close)
+
+	return &Client{
+		BaseURL:    server.URL,
+		Session:    testSession,
+		DataDir:    t.TempDir(),
+		Interval:   time.Millisecond,
+		HTTPClient: server.Client(),
+	}
+}
+
+func TestInput(t *testing.T) {
+	site := &fakeSite{}
+	client := newTestClient(t, site)
+
+	// an empty input file (as created for a new day) is downloaded over
+	filename := client.InputFile(2024, 1)
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filename, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for range 2 {
+		data, err := client.Input(context.Background(), 2024, 1)
+		if err != nil {
+			t.Fatalf("Input Test: unexpected error %v", err)
+		}
+
+		if string(data) != "3   4\n4   3\n" {
+			t.Errorf("Input Test:\nwant %q\ngot %q\n", "3   4\n4   3\n", data)
+		}
+	}
+
+	// the second call reads the cached input
+	if site.requests.Load() != 1 {
+		t.Errorf("Input Test: the number of requests:\nwant %v\ngot %v\n", 1, site.requests.Load())
+	}
+
+	cached, err := os.ReadFile(filename)
+	if err != nil || string(cached) != "3   4\n4   3\n" {
+		t.Errorf("Input Test: the cached input:\nwant %q\ngot %q (%v)\n", "3   4\n4   3\n", cached, err)
+	}
+}
+
+func TestInputErrors(t *testing.T) {
+	client := newTestClient(t, &fakeSite{})
+
+	if _, err := client.Input(context.Background(), 2024, 2); !errors.Is(err, ErrNotAvailable) {
+		t.Errorf("Input Test: a day that isn't available:\nwant %v\ngot %v\n", ErrNotAvailable, err)
+	}
+
+	if _, err := os.Stat(client.InputFile(2024, 2)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("Input Test: a day that isn't available shouldn't be cached: %v", err)
+	}
+
+	client.Session = "expired"
+	if _, err := client.Input(context.Background(), 2024, 1); err == nil {
+		t.Errorf("Input Test: an expired session should be an error")
+	}
+
+	client.Session = ""
+	if _, err := client.Input(context.Background(), 2024, 1); !errors.Is(err, ErrNoSession) {
+		t.Errorf("Input Test: a missing session:\nwant %v\ngot %v\n", ErrNoSession, err)
+	}
+}
+
+func TestSubmit(t *testing.T) {
+	site := &fakeSite{answers: map[string]string{
+		"11":  rightPage,
+		"100": tooHighPage,
+		"1":   tooLowPage,
+		"12":  completePage,
+	}}
+
+	tests := []struct {
+		answer string
+		want   Outcome
+		wait   time.Duration
+	}{
+		{"11", Correct, 0},
+		{"100", TooHigh, time.Minute},
+		{"1", TooLow, 5 * time.Minute},
+		{"13", Incorrect, time.Minute},
+		{"12", AlreadySolved, 0},
+	}
+
+	for _, test := range tests {
+		// a new client for each answer, so the wait after a wrong answer doesn't apply
+		client := newTestClient(t, site)
+
+		verdict, err := client.Submit(context.Background(), 2024, 1, 1, test.answer)
+		if err != nil {
+			t.Fatalf("Submit Test: unexpected error %v", err)
+		}
+
+		if verdict.Outcome != test.want || verdict.Wait != test.wait {
+			t.Errorf("Submit Test: the answer %s:\nwant %v (wait %v)\ngot %v (wait %v)\n", test.answer, test.want, test.wait, verdict.Outcome, verdict.Wait)
+		}
+
+		// the verdict is logged in the day's history, unless it doesn't judge the answer
+		history, err := client.History(2024, 1)
+		if test.want == AlreadySolved {
+			if err != nil || len(history.Submissions) != 0 {
+				t.Errorf("Submit Test: the history of the answer %s:\nwant %v\ngot %v (%v)\n", test.answer, "no submissions", history, err)
+			}
+
+			continue
+		}
+
+		if err != nil || len(history.Submissions) != 1 || history.Submissions[0].Answer != test.answer || history.Submissions[0].Outcome != test.want {
+			t.Errorf("Submit Test: the history of the answer %s:\nwant %v\ngot %v (%v)\n", test.answer, test.want, history, err)
+		}
+	}
+}
+
+func TestSubmitRateLimited(t *testing.T) {
+	site := &fakeSite{answers: map[string]string{"11": tooSoonPage, "100": tooHighPage}}
+	client := newTestClient(t, site)
+
+	verdict, err := client.Submit(context.Background(), 2024, 1, 1, "11")
+	var rateLimit *RateLimitError
+	if !errors.As(err, &rateLimit) || verdict.Outcome != RateLimited || rateLimit.Wait != time.Minute+5*time.Second {
+		t.Fatalf("Submit Test: an answer submitted too recently:\nwant %v (wait %v)\ngot %v (%v)\n", RateLimited, time.Minute+5*time.Second, verdict.Outcome, err)
+	}
+
+	// the site isn't contacted again until the wait has passed
+	if _, err := client.Submit(context.Background(), 2024, 1, 1, "100"); !errors.As(err, &rateLimit) {
+		t.Errorf("Submit Test: an answer submitted during the wait:\nwant %v\ngot %v\n", "a *RateLimitError", err)
+	}
+
+	if site.requests.Load() != 1 {
+		t.Errorf("Submit Test: the number of requests:\nwant %v\ngot %v\n", 1, site.requests.Load())
+	}
+
+	// an answer that wasn't judged isn't logged
+	if history, err := client.History(2024, 1); err != nil || len(history.Submissions) != 0 {
+		t.Errorf("Submit Test: the history:\nwant %v\ngot %v (%v)\n", "no submissions", history, err)
+	}
+}
+
+func TestTooManyRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	client := &Client{BaseURL: server.URL, Session: testSession, DataDir: t.TempDir(), Interval: time.Millisecond}
+
+	_, err := client.Input(context.Background(), 2024, 1)
+	var rateLimit *RateLimitError
+	if !errors.As(err, &rateLimit) || rateLimit.Wait != 30*time.Second {
+		t.Errorf("Too Many Requests Test:\nwant %v\ngot %v\n", "a *RateLimitError to wait 30s", err)
+	}
+}
+
+func TestThrottle(t *testing.T) {
+	site := &fakeSite{}
+	client := newTestClient(t, site)
+	client.Interval = 50 * time.Millisecond
+
+	start := time.Now()
+	for day := 2; day <= 4; day++ {
+		client.Input(context.Background(), 2024, day)
+	}
+
+	// the first request is made immediately and each of the others waits for the interval
+	if elapsed := time.Since(start); elapsed < 2*client.Interval {
+		t.Errorf("Throttle Test: the time taken by 3 requests:\nwant at least %v\ngot %v\n", 2*client.Interval, elapsed)
+	}
+
+	// a request waiting for the interval gives up when its context is done
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Input(ctx, 2024, 5); !errors.Is(err, context.Canceled) {
+		t.Errorf("Throttle Test: a cancelled request:\nwant %v\ngot %v\n", context.Canceled, err)
+	}
+}
+
+func TestParseVerdict(t *testing.T) {
+	tests := []struct {
+		page string
+		want Outcome
+		wait time.Duration
+	}{
+		{rightPage, Correct, 0},
+		{wrongPage, Incorrect, time.Minute},
+		{tooHighPage, TooHigh, time.Minute},
+		{tooLowPage, TooLow, 5 * time.Minute},
+		{tooSoonPage, RateLimited, time.Minute + 5*time.Second},
+		{`<article><p>You gave an answer too recently.  You have 34s left to wait.</p></article>`, RateLimited, 34 * time.Second},
+		{completePage, AlreadySolved, 0},
+		{"<html><body>Something else</body></html>", Unknown, 0},
+	}
+
+	for _, test := range tests {
+		verdict := ParseVerdict(test.page)
+		if verdict.Outcome != test.want || verdict.Wait != test.wait {
+			t.Errorf("Parse Verdict Test: %s\nwant %v (wait %v)\ngot %v (wait %v)\n", verdict.Message, test.want, test.wait, verdict.Outcome, verdict.Wait)
+		}
+	}
+
+	verdict := ParseVerdict(rightPage)
+	if want := "That's the right answer! You are one gold star closer to finding the Chief Historian. [Continue to Part Two]"; verdict.Message != want {
+		t.Errorf("Parse Verdict Test: the message:\nwant %v\ngot %v\n", want, verdict.Message)
+	}
+}
+
+func TestSubmitWaitAcrossClients(t *testing.T) {
+	site := &fakeSite{answers: map[string]string{"100": tooHighPage, "11": rightPage}}
+	client := newTestClient(t, site)
+
+	if _, err := client.Submit(context.Background(), 2024, 1, 1, "100"); err != nil {
+		t.Fatalf("Submit Test: unexpected error %v", err)
+	}
+
+	// a Client created later (as each run of submit does) honours the wait the site asked for
+	later := newTestClient(t, site)
+	later.DataDir = client.DataDir
+
+	var rateLimit *RateLimitError
+	if _, err := later.Submit(context.Background(), 2024, 1, 1, "11"); !errors.As(err, &rateLimit) || rateLimit.Wait <= 0 || rateLimit.Wait > time.Minute {
+		t.Errorf("Submit Test: an answer submitted by a later client during the wait:\nwant %v\ngot %v\n", "a *RateLimitError", err)
+	}
+
+	if site.requests.Load() != 1 {
+		t.Errorf("Submit Test: the number of requests:\nwant %v\ngot %v\n", 1, site.requests.Load())
+	}
+
+	history, err := later.History(2024, 1)
+	if err != nil || history.Wait(time.Now()) <= 0 || history.Wait(time.Now().Add(time.Minute)) != 0 {
+		t.Errorf("Submit Test: the logged wait:\nwant %v\ngot %v (%v)\n", "a wait of up to a minute", history.SubmitAfter, err)
+	}
+}
@@ -0,0 +1,196 @@
+// history.go keeps a log of the answers submitted for a day, so an answer that is known to be
+// wrong isn't submitted again
+package aoc
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HistoryFileName is the name of the file, in the same directory as a day's input, that logs
+// the answers submitted for the day
+const HistoryFileName = "submissions.json"
+
+// ErrKnownWrong is returned by History.Check when an answer is known to be wrong
+var ErrKnownWrong = errors.New("the answer is known to be wrong")
+
+// Submission is an answer that was submitted and the site's verdict
+type Submission struct {
+	Part    int       `json:"part"`
+	Answer  string    `json:"answer"`
+	Outcome Outcome   `json:"outcome"`
+	Time    time.Time `json:"time"`
+}
+
+// History is the log of the answers submitted for a day, oldest first
+type History struct {
+	Submissions []Submission `json:"submissions"`
+
+	// SubmitAfter is when the site will next accept an answer, if it said to wait after the
+	// last answer. It is kept in the log so the wait is honoured by later runs too.
+	SubmitAfter *time.Time `json:"submit_after,omitempty"`
+}
+
+// Bounds are the limits the right answer to a part is known to be within, learned from the
+// answers that were too high or too low. The right answer is greater than Low (if HasLow)
+// and less than High (if HasHigh).
+type Bounds struct {
+	Low, High       int64
+	HasLow, HasHigh bool
+}
+
+// LoadHistory reads the history from the specified file. An empty History is returned if
+// the file doesn't exist.
+func LoadHistory(filename string) (History, error) {
+	var history History
+
+	data, err := os.ReadFile(filename)
+	if errors.Is(err, os.ErrNotExist) {
+		return history, nil
+	} else if err != nil {
+		return history, err
+	}
+
+	if err := json.Unmarshal(data, &history); err != nil {
+		return history, fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	return history, nil
+}
+
+// SaveHistory writes the history to the specified file
+func SaveHistory(filename string, history History) error {
+	data, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
+// Add logs the verdict of an answer submitted at the specified time. Verdicts that don't
+// judge the answer (because the site said to wait, the part was already solved or the
+// response wasn't recognised) aren't logged.
+func (h *History) Add(part int, answer string, verdict Verdict, at time.Time) {
+	switch verdict.Outcome {
+	case RateLimited, AlreadySolved, Unknown:
+		return
+	}
+
+	h.Submissions = append(h.Submissions, Submission{Part: part, Answer: strings.TrimSpace(answer), Outcome: verdict.Outcome, Time: at.UTC()})
+}
+
+// SetWait records that the site won't accept another answer until wait has passed since the
+// specified time
+func (h *History) SetWait(wait time.Duration, at time.Time) {
+	submitAfter := at.Add(wait).UTC()
+	h.SubmitAfter = &submitAfter
+}
+
+// Wait returns how much longer to wait after the specified time before the site will accept
+// another answer, or 0 if it will accept one now
+func (h History) Wait(at time.Time) time.Duration {
+	if h.SubmitAfter == nil {
+		return 0
+	}
+
+	return max(0, h.SubmitAfter.Sub(at))
+}
+
+// Accepted returns the answer to the part that the site said was right, if there is one
+func (h History) Accepted(part int) (string, bool) {
+	for _, submission := range h.Submissions {
+		if submission.Part == part && submission.Outcome == Correct {
+			return submission.Answer, true
+		}
+	}
+
+	return "", false
+}
+
+// Bounds returns the limits the right answer to the part is known to be within
+func (h History) Bounds(part int) Bounds {
+	var bounds Bounds
+
+	for _, submission := range h.Submissions {
+		value, err := strconv.ParseInt(submission.Answer, 10, 64)
+		if submission.Part != part || err != nil {
+			continue
+		}
+
+		switch submission.Outcome {
+		case TooHigh:
+			if !bounds.HasHigh || value < bounds.High {
+				bounds.High, bounds.HasHigh = value, true
+			}
+		case TooLow:
+			if !bounds.HasLow || value > bounds.Low {
+				bounds.Low, bounds.HasLow = value, true
+			}
+		}
+	}
+
+	return bounds
+}
+
+// Check returns an error wrapping ErrKnownWrong if the answer to the part is known to be
+// wrong: it was already submitted and judged wrong, it differs from the answer that was
+// accepted, or it is outside the Bounds of the part.
+func (h History) Check(part int, answer string) error {
+	answer = strings.TrimSpace(answer)
+
+	if accepted, found := h.Accepted(part); found {
+		if answer == accepted {
+			return nil
+		}
+
+		return fmt.Errorf("%w: %s was accepted as the answer to part %d", ErrKnownWrong, accepted, part)
+	}
+
+	for _, submission := range h.Submissions {
+		if submission.Part == part && submission.Answer == answer {
+			return fmt.Errorf("%w: %s was submitted on %s and was %s", ErrKnownWrong, answer, submission.Time.Local().Format(time.DateTime), submission.Outcome)
+		}
+	}
+
+	value, err := strconv.ParseInt(answer, 10, 64)
+	if err != nil {
+		// the bounds only apply to numbers
+		return nil
+	}
+
+	bounds := h.Bounds(part)
+	if bounds.HasHigh && value >= bounds.High {
+		return fmt.Errorf("%w: %s is too high, since %d was too high", ErrKnownWrong, answer, bounds.High)
+	}
+
+	if bounds.HasLow && value <= bounds.Low {
+		return fmt.Errorf("%w: %s is too low, since %d was too low", ErrKnownWrong, answer, bounds.Low)
+	}
+
+	return nil
+}
+
+// String describes the bounds, such as "between 10 and 20" or "less than 20"
+func (b Bounds) String() string {
+	switch {
+	case b.HasLow && b.HasHigh:
+		return fmt.Sprintf("between %d and %d", b.Low, b.High)
+	case b.HasLow:
+		return fmt.Sprintf("greater than %d", b.Low)
+	case b.HasHigh:
+		return fmt.Sprintf("less than %d", b.High)
+	}
+
+	return "unknown"
+}
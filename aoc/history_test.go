@@ -0,0 +1,80 @@
+package aoc
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestHistoryCheck(t *testing.T) {
+	at := time.Date(2024, 12, 1, 5, 0, 0, 0, time.UTC)
+
+	var history History
+	history.Add(1, "100", Verdict{Outcome: TooHigh}, at)
+	history.Add(1, "150", Verdict{Outcome: TooHigh}, at)
+	history.Add(1, "10", Verdict{Outcome: TooLow}, at)
+	history.Add(1, "42", Verdict{Outcome: Incorrect}, at)
+	history.Add(1, "50", Verdict{Outcome: RateLimited}, at)
+	history.Add(1, "60", Verdict{Outcome: AlreadySolved}, at)
+	history.Add(2, "abc", Verdict{Outcome: Incorrect}, at)
+	history.Add(2, "7", Verdict{Outcome: Correct}, at)
+
+	if len(history.Submissions) != 6 {
+		t.Errorf("History Test: the number of submissions logged:\nwant %v\ngot %v\n", 6, len(history.Submissions))
+	}
+
+	want := Bounds{Low: 10, High: 100, HasLow: true, HasHigh: true}
+	if bounds := history.Bounds(1); bounds != want {
+		t.Errorf("History Test: the bounds of part 1:\nwant %v\ngot %v\n", want, bounds)
+	}
+
+	tests := []struct {
+		part   int
+		answer string
+		wrong  bool
+	}{
+		{1, "100", true}, // too high
+		{1, "120", true}, // above a too high answer
+		{1, "10", true},  // too low
+		{1, "5", true},   // below a too low answer
+		{1, "42", true},  // incorrect
+		{1, "50", false}, // wasn't judged
+		{1, "60", false}, // wasn't judged, since the part was already solved
+		{1, "99", false},
+		{1, "xyz", false}, // not a number, so the bounds don't apply
+		{2, "7", false},   // accepted
+		{2, "8", true},    // not the accepted answer
+	}
+
+	for _, test := range tests {
+		err := history.Check(test.part, test.answer)
+		if errors.Is(err, ErrKnownWrong) != test.wrong {
+			t.Errorf("History Test: part %d, answer %s:\nwant known wrong %v\ngot %v\n", test.part, test.answer, test.wrong, err)
+		}
+	}
+}
+
+func TestHistoryLoadSave(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "2024", "day1", HistoryFileName)
+
+	history, err := LoadHistory(filename)
+	if err != nil || len(history.Submissions) != 0 {
+		t.Fatalf("History Test: a missing file:\nwant %v\ngot %v (%v)\n", "an empty history", history, err)
+	}
+
+	history.Add(1, " 11 ", Verdict{Outcome: Correct}, time.Date(2024, 12, 1, 5, 0, 0, 0, time.UTC))
+	if err := SaveHistory(filename, history); err != nil {
+		t.Fatalf("History Test: unexpected error %v", err)
+	}
+
+	loaded, err := LoadHistory(filename)
+	if err != nil {
+		t.Fatalf("History Test: unexpected error %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded, history) || loaded.Submissions[0].Answer != "11" {
+		t.Errorf("History Test: the loaded history:\nwant %v\ngot %v\n", history, loaded)
+	}
+}
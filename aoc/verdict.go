@@ -0,0 +1,95 @@
+// verdict.go parses the page the Advent of Code site responds with when an answer is submitted
+package aoc
+
+import (
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Outcome is the site's judgement of a submitted answer
+type Outcome string
+
+const (
+	Correct       Outcome = "correct"        // the answer is right
+	Incorrect     Outcome = "incorrect"      // the answer is wrong, without a hint
+	TooHigh       Outcome = "too high"       // the answer is wrong and is higher than the right answer
+	TooLow        Outcome = "too low"        // the answer is wrong and is lower than the right answer
+	RateLimited   Outcome = "rate limited"   // the answer wasn't checked because the last was submitted too recently
+	AlreadySolved Outcome = "already solved" // the answer wasn't checked because the part has already been solved
+	Unknown       Outcome = "unknown"        // the response wasn't recognised
+)
+
+// Verdict is the site's response to a submitted answer
+type Verdict struct {
+	Outcome Outcome
+	Wait    time.Duration // how long to wait before submitting another answer, if the site said
+	Message string        // the text of the response
+}
+
+var (
+	// articlePattern matches the part of the page that holds the response
+	articlePattern = regexp.MustCompile(`(?s)<article[^>]*>(.*?)</article>`)
+
+	// tagPattern matches the HTML tags in the response
+	tagPattern = regexp.MustCompile(`<[^>]*>`)
+
+	// waitPattern matches how long the response says to wait, such as "You have 1m 5s left
+	// to wait" or "please wait one minute before trying again"
+	waitPattern = regexp.MustCompile(`(?i)you have (?:(\d+)m ?)?(?:(\d+)s )?left to wait|please wait (one|two|three|four|five|six|seven|eight|nine|ten|\d+) minutes?`)
+)
+
+// numbers are the numbers of minutes the site spells out when it says to wait
+var numbers = map[string]int{"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9, "ten": 10}
+
+// ParseVerdict parses the page the site responds with when an answer is submitted
+func ParseVerdict(page string) Verdict {
+	text := page
+	if matches := articlePattern.FindStringSubmatch(page); matches != nil {
+		text = matches[1]
+	}
+
+	text = strings.Join(strings.Fields(html.UnescapeString(tagPattern.ReplaceAllString(text, ""))), " ")
+	verdict := Verdict{Outcome: Unknown, Message: text, Wait: wait(text)}
+
+	switch lower := strings.ToLower(text); {
+	case strings.Contains(lower, "that's the right answer"):
+		verdict.Outcome = Correct
+	case strings.Contains(lower, "answer too recently"):
+		verdict.Outcome = RateLimited
+	case strings.Contains(lower, "your answer is too high"):
+		verdict.Outcome = TooHigh
+	case strings.Contains(lower, "your answer is too low"):
+		verdict.Outcome = TooLow
+	case strings.Contains(lower, "that's not the right answer"):
+		verdict.Outcome = Incorrect
+	case strings.Contains(lower, "did you already complete it"):
+		verdict.Outcome = AlreadySolved
+	}
+
+	return verdict
+}
+
+// wait returns how long the text says to wait, or 0 if it doesn't say
+func wait(text string) time.Duration {
+	matches := waitPattern.FindStringSubmatch(text)
+	if matches == nil {
+		return 0
+	}
+
+	if matches[3] != "" {
+		minutes, found := numbers[strings.ToLower(matches[3])]
+		if !found {
+			minutes, _ = strconv.Atoi(matches[3])
+		}
+
+		return time.Duration(minutes) * time.Minute
+	}
+
+	minutes, _ := strconv.Atoi(matches[1])
+	seconds, _ := strconv.Atoi(matches[2])
+
+	return time.Duration(minutes)*time.Minute + time.Duration(seconds)*time.Second
+}
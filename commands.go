@@ -0,0 +1,743 @@
+// commands.go implements the commands that can be specified on the command line so that
+// scripts can run the exercises without going through the interactive menu
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/trentnix/aoc2024/aoc"
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/runner"
+)
+
+// the exit codes returned by the commands
+const (
+	exitOK      = 0 // the command succeeded
+	exitFailure = 1 // an exercise failed to run or produced an error
+	exitUsage   = 2 // the command line was invalid
+)
+
+// command is a command that can be specified on the command line
+type command struct {
+	name        string
+	description string
+	run         func(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int
+}
+
+// commands contains each command that can be specified on the command line in the order
+// they are listed in the usage
+var commands []command
+
+// init initializes the commands slice
+func init() {
+	commands = []command{
+		{name: "run", description: "run a day (or a single part of a day), or every day with -all", run: runCommand},
+		{name: "list", description: "list the available exercises", run: listCommand},
+		{name: "bench", description: "time repeated runs of each part of a day (or every day) and compare against a previous run", run: benchCommand},
+		{name: "check", description: "compare the answers of each day to the accepted answers in data/YYYY/dayN/answers.json", run: checkCommand},
+		{name: "fetch", description: "download the input of a day from the Advent of Code site into data/YYYY/dayN/input.txt", run: fetchCommand},
+		{name: "submit", description: "submit the answer to a part of a day to the Advent of Code site", run: submitCommand},
+		{name: "show", description: "show the puzzle description of a day", run: showCommand},
+		{name: "search", description: "search the puzzle descriptions and optionally run the best match", run: searchCommand},
+		{name: "new", description: "generate a new day from the DayX template", run: newCommand},
+	}
+}
+
+// findCommand returns the command with the specified name
+func findCommand(name string) (command, bool) {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return cmd, true
+		}
+	}
+
+	return command{}, false
+}
+
+// usage writes the commands that can be specified on the command line to w
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: aoc2024 [command] [flags]")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "The interactive menu is displayed if no command is specified.")
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-8s%s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(w, "")
+	fmt.Fprintln(w, "Run 'aoc2024 [command] -help' to see the flags of a command.")
+}
+
+// newFlagSet returns a flag set for the named command that reports parse errors (rather
+// than exiting) and writes its usage to stderr
+func newFlagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(os.Stderr)
+
+	return fs
+}
+
+// parseFlags parses args into the flag set and makes sure there are no unexpected
+// positional arguments. The exit code to return is provided if parsing failed.
+func parseFlags(fs *flag.FlagSet, args []string) (int, bool) {
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK, false
+		}
+
+		return exitUsage, false
+	}
+
+	if fs.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "unexpected arguments: %v\n", fs.Args())
+		fs.Usage()
+		return exitUsage, false
+	}
+
+	return exitOK, true
+}
+
+// runCommand runs the specified day (or a single part of the day) and writes the answers
+// to w. If -all is specified, every day is run against its default input file and a
+// summary table is written instead. With -format json, a JSON document with a record for
+// each part is written in either case.
+func runCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("run")
+	day := fs.String("day", "", "the day to run, as N (day N of -year) or YYYY/N (required unless -all is specified)")
+	year := fs.Int("year", 0, "the year of the days to run; the most recent year is used for -day N, and every year is run by -all if not specified")
+	all := fs.Bool("all", false, "run every day against its default input file and write a summary table")
+	part := fs.Int("part", 0, "the part of the day to run (1 or 2); both parts are run if not specified")
+	inputFile := fs.String("input", "", "the input file, or - to read stdin; the day's default input file is used if not specified")
+	format := fs.String("format", "text", "the output format (text or json)")
+	timeout := fs.Duration("timeout", time.Minute, "how long a day (or the selected part) may run before it is reported as timed out (0 for no limit)")
+	workers := fs.Int("workers", 1, "how many days -all runs at once (0 for one per CPU)")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if err := validatePart(*part); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if *format != "text" && *format != "json" {
+		fmt.Fprintf(os.Stderr, "unsupported output format %q\n", *format)
+		return exitUsage
+	}
+
+	if *workers < 0 {
+		fmt.Fprintf(os.Stderr, "invalid number of workers %d\n", *workers)
+		return exitUsage
+	}
+
+	if *workers == 0 {
+		*workers = runtime.NumCPU()
+	}
+
+	opts := runner.Options{Part: *part, InputFile: *inputFile, Timeout: *timeout, Workers: *workers}
+
+	if *all {
+		if *day != "" || *inputFile != "" {
+			fmt.Fprintln(os.Stderr, "-day and -input can't be used with -all")
+			return exitUsage
+		}
+
+		selected, err := selectExercises(registrations, *year, "")
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitUsage
+		}
+
+		reports := runner.RunAll(ctx, selected, opts)
+
+		write := runner.WriteSummary
+		if *format == "json" {
+			write = runner.WriteJSON
+		}
+
+		if err := write(w, reports); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitFailure
+		}
+
+		if runner.Failed(reports) {
+			return exitFailure
+		}
+
+		return exitOK
+	}
+
+	registration, err := parseDay(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	report := runner.Run(ctx, registration, opts)
+	if *format == "json" {
+		if err := runner.WriteJSON(w, []runner.Report{report}); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitFailure
+		}
+	} else {
+		printResult(w, report.Result, report.Err)
+	}
+
+	if report.Status != runner.StatusOK {
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// listCommand writes the day number and name of each available exercise to w
+func listCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("list")
+	year := fs.Int("year", 0, "only list the days of this year")
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	selected, err := selectExercises(registrations, *year, "")
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	for _, registration := range selected {
+		fmt.Fprintf(w, "%d : %s - %s\n", registration.Day, registration.Name(), registration.Title)
+	}
+
+	return exitOK
+}
+
+// benchCommand runs each part of the specified day (or every day) repeatedly against its
+// default input file and writes the statistics of the runs to w. The results can be saved
+// to a file and compared against the results saved by a previous run to flag regressions.
+func benchCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("bench")
+	day := fs.String("day", "", "the day to benchmark, as N (day N of -year) or YYYY/N; every day is benchmarked if not specified")
+	year := fs.Int("year", 0, "the year of the days to benchmark; every year is benchmarked if neither -year nor -day is specified")
+	part := fs.Int("part", 0, "the part of the day to benchmark (1 or 2); both parts are benchmarked if not specified")
+	runs := fs.Int("n", 10, "the number of times to run each part")
+	timeout := fs.Duration("timeout", time.Minute, "how long each run of a part may take before it is reported as timed out (0 for no limit)")
+	save := fs.String("save", "", "save the results to this file")
+	compare := fs.String("compare", "", "compare the results against the results saved to this file by a previous run")
+	threshold := fs.Float64("threshold", 0.1, "how much slower (0.1 is 10%) the median can be than the compared median before it is flagged as a regression")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if err := validatePart(*part); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if *runs < 1 {
+		fmt.Fprintf(os.Stderr, "invalid number of runs %d\n", *runs)
+		return exitUsage
+	}
+
+	var baseline []runner.Benchmark
+	if *compare != "" {
+		var err error
+		baseline, err = runner.LoadBenchmarks(*compare)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitFailure
+		}
+	}
+
+	registrations, err := selectExercises(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	code := exitOK
+	var benchmarks []runner.Benchmark
+	for _, registration := range registrations {
+		ex := registration.Exercise
+		input, err := runner.ReadInput(ex, "")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s - %v\n", ex.GetName(), err)
+			code = exitFailure
+			continue
+		}
+
+		for _, p := range parts {
+			benchmark, err := runner.Bench(ctx, ex, input, p, *runs, *timeout)
+			if errors.Is(err, exercise.ErrInvalidPart) && *part == 0 {
+				// the exercise doesn't have this part (e.g. the last day only has one part)
+				continue
+			} else if err != nil {
+				fmt.Fprintf(os.Stderr, "%s - Part %d - %v\n", ex.GetName(), p, err)
+				code = exitFailure
+				continue
+			}
+
+			benchmarks = append(benchmarks, benchmark)
+		}
+	}
+
+	comparisons := runner.Compare(benchmarks, baseline, *threshold)
+	if err := runner.WriteBenchmarks(w, comparisons); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	if *save != "" {
+		if err := runner.SaveBenchmarks(*save, benchmarks); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return exitFailure
+		}
+	}
+
+	if runner.Regressed(comparisons) {
+		code = exitFailure
+	}
+
+	return code
+}
+
+// checkCommand runs the specified day (or every day) against its default input file and
+// compares the answers to the accepted answers stored alongside the input file. With
+// -update, the answers that are produced are stored as the accepted answers instead.
+func checkCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("check")
+	day := fs.String("day", "", "the day to check, as N (day N of -year) or YYYY/N; every day is checked if not specified")
+	year := fs.Int("year", 0, "the year of the days to check; every year is checked if neither -year nor -day is specified")
+	part := fs.Int("part", 0, "the part of the day to check (1 or 2); both parts are checked if not specified")
+	timeout := fs.Duration("timeout", time.Minute, "how long a day (or the selected part) may run before it is reported as timed out (0 for no limit)")
+	workers := fs.Int("workers", 1, "how many days are run at once (0 for one per CPU)")
+	update := fs.Bool("update", false, "store the answers that are produced as the accepted answers")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if err := validatePart(*part); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if *workers < 0 {
+		fmt.Fprintf(os.Stderr, "invalid number of workers %d\n", *workers)
+		return exitUsage
+	}
+
+	if *workers == 0 {
+		*workers = runtime.NumCPU()
+	}
+
+	registrations, err := selectExercises(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	parts := []int{1, 2}
+	if *part != 0 {
+		parts = []int{*part}
+	}
+
+	reports := runner.RunAll(ctx, registrations, runner.Options{Part: *part, Timeout: *timeout, Workers: *workers})
+
+	var checks []runner.Check
+	code := exitOK
+	for i, report := range reports {
+		filename := runner.AnswersFile(registrations[i].Exercise)
+		if filename == "" {
+			fmt.Fprintf(os.Stderr, "%s - a default input file is not specified\n", report.Name)
+			code = exitFailure
+			continue
+		}
+
+		answers, err := runner.LoadAnswers(filename)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%s - %v\n", report.Name, err)
+			code = exitFailure
+			continue
+		}
+
+		if *update {
+			for _, answer := range report.Result.Answers {
+				if answer.Err == nil && answer.String() != "" {
+					answers.SetPart(answer.Part, answer.String())
+				}
+			}
+
+			if err := runner.SaveAnswers(filename, answers); err != nil {
+				fmt.Fprintf(os.Stderr, "%s - %v\n", report.Name, err)
+				code = exitFailure
+				continue
+			}
+		}
+
+		checks = append(checks, runner.CheckReport(report, answers, parts...)...)
+	}
+
+	if err := runner.WriteChecks(w, checks); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	if runner.CheckFailed(checks) {
+		code = exitFailure
+	}
+
+	return code
+}
+
+// fetchCommand downloads the input of the specified day into its default input file, using
+// the session token in $AOC_SESSION. An input that has already been downloaded is left as it is.
+func fetchCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("fetch")
+	day := fs.String("day", "", "the day to download the input of, as N (day N of -year) or YYYY/N (required)")
+	year := fs.Int("year", 0, "the year of the day; the most recent year is used if not specified")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	key, err := parseDayKey(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	client := aoc.NewClient("")
+	input, err := client.Input(ctx, key.Year, key.Day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	fmt.Fprintf(w, "%s (%d bytes)\n", client.InputFile(key.Year, key.Day), len(input))
+	return exitOK
+}
+
+// submitCommand submits the answer to a part of the specified day, using the session token
+// in $AOC_SESSION, and writes the site's verdict to w. If -answer isn't specified, the part
+// is run against its default input file and its answer is submitted. The answer isn't
+// submitted if data/YYYY/dayN/submissions.json shows it is wrong, unless -force is
+// specified. A correct answer is stored as the accepted answer in data/YYYY/dayN/answers.json.
+func submitCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("submit")
+	day := fs.String("day", "", "the day to submit the answer to, as N (day N of -year) or YYYY/N (required)")
+	year := fs.Int("year", 0, "the year of the day; the most recent year is used if not specified")
+	part := fs.Int("part", 0, "the part the answer is to (1 or 2) (required)")
+	answer := fs.String("answer", "", "the answer to submit; the part is run to produce the answer if not specified")
+	timeout := fs.Duration("timeout", time.Minute, "how long the part may run before it is reported as timed out (0 for no limit)")
+	force := fs.Bool("force", false, "submit the answer even if the submission history shows it is wrong")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	if *part != 1 && *part != 2 {
+		fmt.Fprintf(os.Stderr, "invalid part %d: expected 1 or 2\n", *part)
+		return exitUsage
+	}
+
+	registration, err := parseDay(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	client := aoc.NewClient("")
+	if client.Session == "" {
+		fmt.Fprintln(os.Stderr, aoc.ErrNoSession)
+		return exitUsage
+	}
+
+	if *answer == "" {
+		report := runner.Run(ctx, registration, runner.Options{Part: *part, Timeout: *timeout})
+		printResult(w, report.Result, report.Err)
+
+		if report.Status != runner.StatusOK {
+			return exitFailure
+		}
+
+		for _, produced := range report.Result.Answers {
+			if produced.Part == *part {
+				*answer = produced.String()
+			}
+		}
+	}
+
+	verdict, err := submitAnswer(ctx, w, client, registration, *part, strings.TrimSpace(*answer), *force)
+	if errors.Is(err, aoc.ErrKnownWrong) {
+		fmt.Fprintf(os.Stderr, "%v (use -force to submit it anyway)\n", err)
+		return exitFailure
+	} else if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	if verdict.Outcome != aoc.Correct {
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// showCommand writes the puzzle description of the specified day to w, wrapped to the
+// width of the terminal, or shows it in a pager with -page
+func showCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("show")
+	day := fs.String("day", "", "the day to show, as N (day N of -year) or YYYY/N (required)")
+	year := fs.Int("year", 0, "the year of the day; the most recent year is used if not specified")
+	width := fs.Int("width", 0, "the width to wrap the text to; $COLUMNS (or 80) is used if not specified")
+	paged := fs.Bool("page", false, "show the description in $PAGER (or less)")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	registration, err := parseDay(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if err := showPuzzle(w, registration, *width, *paged); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	return exitOK
+}
+
+// searchCommand searches the puzzle descriptions for the words given as arguments and
+// writes the best matches to w. With -run, the best matching day is run as well.
+func searchCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("search")
+	limit := fs.Int("limit", 5, "the maximum number of matches to list (0 for every match)")
+	run := fs.Bool("run", false, "run the best matching day against its default input file")
+	timeout := fs.Duration("timeout", time.Minute, "how long the day run by -run may run before it is reported as timed out (0 for no limit)")
+
+	// the arguments that follow the flags are the words to search for
+	if err := fs.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+
+		return exitUsage
+	}
+
+	query := strings.Join(fs.Args(), " ")
+	if query == "" {
+		fmt.Fprintln(os.Stderr, "the words to search for are required, e.g. search keypad robots")
+		return exitUsage
+	}
+
+	matches, err := searchPuzzles(w, registrations, query, *limit)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	if len(matches) == 0 {
+		return exitFailure
+	}
+
+	if !*run {
+		return exitOK
+	}
+
+	for _, match := range matches {
+		registration, err := findExercise(registrations, match.Document.Year, match.Document.Day)
+		if err != nil {
+			// the description isn't of a day that has been implemented
+			continue
+		}
+
+		fmt.Fprintln(w)
+		report := runner.Run(ctx, registration, runner.Options{Timeout: *timeout})
+		printResult(w, report.Result, report.Err)
+
+		if report.Status != runner.StatusOK {
+			return exitFailure
+		}
+
+		return exitOK
+	}
+
+	fmt.Fprintln(os.Stderr, "none of the matches is a day that can be run")
+	return exitFailure
+}
+
+// newCommand generates the implementation, tests and input file of a new day from the DayX
+// template. The day is registered in its own file, so it is available once the program is
+// rebuilt.
+func newCommand(ctx context.Context, w io.Writer, registrations []exercise.Registration, args []string) int {
+	fs := newFlagSet("new")
+	day := fs.String("day", "", "the new day, as N (day N of -year) or YYYY/N (required)")
+	year := fs.Int("year", 0, "the year of the new day; the most recent year is used if not specified")
+	title := fs.String("title", "", "the title of the puzzle; the title in data/YYYY/dayN/dayN.md is used if not specified")
+	root := fs.String("root", ".", "the root directory of the repository")
+
+	if code, ok := parseFlags(fs, args); !ok {
+		return code
+	}
+
+	key, err := parseDayKey(registrations, *year, *day)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitUsage
+	}
+
+	if registration, err := findExercise(registrations, key.Year, key.Day); err == nil {
+		fmt.Fprintf(os.Stderr, "%s is already registered\n", registration.Name())
+		return exitUsage
+	}
+
+	files, err := newDay(*root, key.Year, key.Day, *title)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return exitFailure
+	}
+
+	fmt.Fprintln(w, "created", files.Source)
+	fmt.Fprintln(w, "created", files.Test)
+	fmt.Fprintln(w, "created", files.InputFile)
+	if files.Main != "" {
+		fmt.Fprintln(w, "updated", files.Main)
+	}
+
+	return exitOK
+}
+
+// findExercise returns the exercise registered for the specified year and day
+func findExercise(registrations []exercise.Registration, year, day int) (exercise.Registration, error) {
+	for _, registration := range registrations {
+		if registration.Key() == (exercise.Key{Year: year, Day: day}) {
+			return registration, nil
+		}
+	}
+
+	return exercise.Registration{}, fmt.Errorf("invalid day %d: there is no exercise for day %d of %d", day, day, year)
+}
+
+// parseDay returns the exercise for a day specified as "N" (day N of the specified year, or
+// of the most recent year if year is 0) or as "YYYY/N"
+func parseDay(registrations []exercise.Registration, year int, s string) (exercise.Registration, error) {
+	key, err := parseDayKey(registrations, year, s)
+	if err != nil {
+		return exercise.Registration{}, err
+	}
+
+	return findExercise(registrations, key.Year, key.Day)
+}
+
+// parseDayKey returns the year and day of a day specified in the same way as for parseDay,
+// whether or not an exercise is registered for it
+func parseDayKey(registrations []exercise.Registration, year int, s string) (exercise.Key, error) {
+	if year == 0 {
+		year = latestYear(registrations)
+	}
+
+	yearText, dayText, found := strings.Cut(s, "/")
+	if !found {
+		dayText = s
+	} else {
+		var err error
+		if year, err = strconv.Atoi(yearText); err != nil {
+			return exercise.Key{}, fmt.Errorf("invalid day %q: expected N or YYYY/N", s)
+		}
+	}
+
+	day, err := strconv.Atoi(dayText)
+	if err != nil {
+		return exercise.Key{}, fmt.Errorf("invalid day %q: expected N or YYYY/N", s)
+	}
+
+	if day < 1 || day > 25 {
+		return exercise.Key{}, fmt.Errorf("invalid day %d: expected a day from 1 to 25", day)
+	}
+
+	return exercise.Key{Year: year, Day: day}, nil
+}
+
+// selectExercises returns the exercise for the specified day (see parseDay) or, if day is
+// empty, the exercises of the specified year. Every exercise is returned if neither is
+// specified.
+func selectExercises(registrations []exercise.Registration, year int, day string) ([]exercise.Registration, error) {
+	if day != "" {
+		registration, err := parseDay(registrations, year, day)
+		if err != nil {
+			return nil, err
+		}
+
+		return []exercise.Registration{registration}, nil
+	}
+
+	if year == 0 {
+		return registrations, nil
+	}
+
+	var selected []exercise.Registration
+	for _, registration := range registrations {
+		if registration.Year == year {
+			selected = append(selected, registration)
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("invalid year %d: there are no exercises for %d", year, year)
+	}
+
+	return selected, nil
+}
+
+// years returns each year that has a registered exercise, in order
+func years(registrations []exercise.Registration) []int {
+	var years []int
+	for _, registration := range registrations {
+		if !slices.Contains(years, registration.Year) {
+			years = append(years, registration.Year)
+		}
+	}
+
+	slices.Sort(years)
+
+	return years
+}
+
+// latestYear returns the most recent year that has a registered exercise
+func latestYear(registrations []exercise.Registration) int {
+	year := 0
+	for _, registration := range registrations {
+		year = max(year, registration.Year)
+	}
+
+	return year
+}
+
+// validatePart returns an error if the part isn't 0 (both parts), 1 or 2
+func validatePart(part int) error {
+	if part < 0 || part > 2 {
+		return fmt.Errorf("invalid part %d: expected 1 or 2", part)
+	}
+
+	return nil
+}
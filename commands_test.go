@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+func TestFindExercise(t *testing.T) {
+	registrations := exercise.Registrations()
+	last := registrations[len(registrations)-1]
+
+	registration, err := findExercise(registrations, last.Year, last.Day)
+	if err != nil || registration.Exercise != last.Exercise {
+		t.Errorf("findExercise Test (last day):\nwant %v\ngot %v (%v)\n", last.Name(), registration.Name(), err)
+	}
+
+	for _, day := range []int{0, -1, last.Day + 1} {
+		if _, err := findExercise(registrations, last.Year, day); err == nil {
+			t.Errorf("findExercise Test (day %d):\nwant an error\ngot %v\n", day, err)
+		}
+	}
+}
+
+func TestRunCommandInvalidFlags(t *testing.T) {
+	registrations := exercise.Registrations()
+
+	var buf bytes.Buffer
+	for _, args := range [][]string{
+		{"-day", "0"},
+		{"-day", "1", "-part", "3"},
+		{"-day", "1", "-format", "xml"},
+		{"-day", "1", "extra"},
+	} {
+		if code := runCommand(context.Background(), &buf, registrations, args); code != exitUsage {
+			t.Errorf("runCommand Test %v:\nwant %v\ngot %v\n", args, exitUsage, code)
+		}
+	}
+}
+
+func TestParseDay(t *testing.T) {
+	registrations := []exercise.Registration{
+		{Info: exercise.Info{Year: 2023, Day: 5}},
+		{Info: exercise.Info{Year: 2024, Day: 1}},
+		{Info: exercise.Info{Year: 2024, Day: 5}},
+	}
+
+	for _, test := range []struct {
+		year int
+		day  string
+		want exercise.Key
+	}{
+		{0, "5", exercise.Key{Year: 2024, Day: 5}},
+		{2023, "5", exercise.Key{Year: 2023, Day: 5}},
+		{0, "2023/5", exercise.Key{Year: 2023, Day: 5}},
+		{2024, "2023/5", exercise.Key{Year: 2023, Day: 5}},
+	} {
+		registration, err := parseDay(registrations, test.year, test.day)
+		if err != nil || registration.Key() != test.want {
+			t.Errorf("parseDay Test (%d, %q):\nwant %v\ngot %v (%v)\n", test.year, test.day, test.want, registration.Key(), err)
+		}
+	}
+
+	for _, day := range []string{"2023/1", "x", "2023/", "/5", "6"} {
+		if _, err := parseDay(registrations, 0, day); err == nil {
+			t.Errorf("parseDay Test (%q):\nwant an error\ngot %v\n", day, err)
+		}
+	}
+
+	// fetch and new take days that aren't registered yet
+	for _, test := range []struct {
+		year int
+		day  string
+		want exercise.Key
+	}{
+		{0, "6", exercise.Key{Year: 2024, Day: 6}},
+		{2023, "25", exercise.Key{Year: 2023, Day: 25}},
+		{0, "2025/1", exercise.Key{Year: 2025, Day: 1}},
+	} {
+		key, err := parseDayKey(registrations, test.year, test.day)
+		if err != nil || key != test.want {
+			t.Errorf("parseDayKey Test (%d, %q):\nwant %v\ngot %v (%v)\n", test.year, test.day, test.want, key, err)
+		}
+	}
+
+	for _, day := range []string{"", "0", "26", "x", "2025/", "/5"} {
+		if _, err := parseDayKey(registrations, 0, day); err == nil {
+			t.Errorf("parseDayKey Test (%q):\nwant an error\ngot %v\n", day, err)
+		}
+	}
+
+	selected, err := selectExercises(registrations, 2024, "")
+	if err != nil || len(selected) != 2 || selected[0].Year != 2024 || selected[1].Year != 2024 {
+		t.Errorf("selectExercises Test (2024):\nwant 2 exercises of 2024\ngot %v (%v)\n", selected, err)
+	}
+
+	if _, err := selectExercises(registrations, 2022, ""); err == nil {
+		t.Errorf("selectExercises Test (2022):\nwant an error\ngot %v\n", err)
+	}
+
+	if all := years(registrations); len(all) != 2 || all[0] != 2023 || all[1] != 2024 {
+		t.Errorf("years Test:\nwant %v\ngot %v\n", []int{2023, 2024}, all)
+	}
+}
@@ -0,0 +1,22 @@
+//go:build embed
+
+// embed.go compiles the data directory into the binary when it is built with the embed
+// tag (go build -tags embed), so the binary can be run from any directory
+package main
+
+import (
+	"embed"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
+)
+
+// data contains the puzzle descriptions, inputs and accepted answers of every day
+//
+//go:embed data
+var data embed.FS
+
+// init makes the embedded data available to the exercises when their input files can't
+// be found on disk
+func init() {
+	fileprocessing.SetEmbedded(data)
+}
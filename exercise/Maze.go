@@ -4,11 +4,14 @@ package exercise
 import (
 	"container/heap"
 	"fmt"
+
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
 	MazePoint struct {
-		Y, X      int
+		geometry.Point
 		pointCost int
 	}
 
@@ -18,15 +21,15 @@ type (
 	}
 
 	MazeEdge struct {
-		to        *MazeNode // Destination node
-		cost      int       // Distance to the destination
-		direction int       // Direction of the edge (north, east, south, west)
+		to        *MazeNode          // Destination node
+		cost      int                // Distance to the destination
+		direction geometry.Direction // Direction of the edge
 	}
 
-	Maze [][]MazeLocation
-
-	MazeLocation struct {
-		val rune
+	// Maze is a grid of walls (#), open positions (.) and, for some exercises, the start (S)
+	// and end (E) positions
+	Maze struct {
+		*grid.Grid[rune]
 	}
 
 	MazePath struct {
@@ -37,62 +40,44 @@ type (
 	MazeGraph map[MazePoint]*MazeNode
 )
 
-const (
-	north = iota
-	east
-	south
-	west
-)
-
-var directionDeltas = []struct {
-	dy, dx int
-}{
-	{-1, 0}, // north
-	{0, 1},  // east
-	{1, 0},  // south
-	{0, -1}, // west
-}
-
 // buildGraph takes the specified MemoryMaze and builds a graph structure out
 // of the maze
 func buildMazeGraph(maze Maze) MazeGraph {
 	graph := make(MazeGraph)
 
-	for y := range maze {
-		for x := range maze[y] {
-			current := MazePoint{X: x, Y: y}
+	for position, val := range maze.All() {
+		current := MazePoint{Point: position}
 
-			// skip walls
-			if maze[y][x].val == '#' {
-				continue
-			}
+		// skip walls
+		if val == '#' {
+			continue
+		}
 
-			// identify nodes: intersections, corners, endpoints, or dead ends
-			if isMazeNode(maze, current) {
-				if graph[current] == nil {
-					graph[current] = &MazeNode{
-						point: current,
-						edges: []MazeEdge{},
-					}
+		// identify nodes: intersections, corners, endpoints, or dead ends
+		if isMazeNode(maze, current) {
+			if graph[current] == nil {
+				graph[current] = &MazeNode{
+					point: current,
+					edges: []MazeEdge{},
 				}
+			}
 
-				// Explore paths from this node
-				for dir := 0; dir < 4; dir++ { // Iterate over all 4 directions
-					if neighbor, cost := findNextMazeNode(maze, current, dir); neighbor != nil {
-						if graph[*neighbor] == nil {
-							graph[*neighbor] = &MazeNode{
-								point: *neighbor,
-								edges: []MazeEdge{},
-							}
+			// Explore paths from this node
+			for _, dir := range geometry.Directions { // Iterate over all 4 directions
+				if neighbor, cost := findNextMazeNode(maze, current, dir); neighbor != nil {
+					if graph[*neighbor] == nil {
+						graph[*neighbor] = &MazeNode{
+							point: *neighbor,
+							edges: []MazeEdge{},
 						}
-
-						// Add an edge between the current node and the found neighbor
-						graph[current].edges = append(graph[current].edges, MazeEdge{
-							to:        graph[*neighbor],
-							cost:      cost,
-							direction: dir,
-						})
 					}
+
+					// Add an edge between the current node and the found neighbor
+					graph[current].edges = append(graph[current].edges, MazeEdge{
+						to:        graph[*neighbor],
+						cost:      cost,
+						direction: dir,
+					})
 				}
 			}
 		}
@@ -103,27 +88,21 @@ func buildMazeGraph(maze Maze) MazeGraph {
 
 // findNextNode traverses the graph according to the specified direction, returning
 // the next point to traverse and the next direction faced
-func findNextMazeNode(maze Maze, start MazePoint, direction int) (*MazePoint, int) {
-	x, y := start.X, start.Y
-	dy, dx := directionDeltas[direction].dy, directionDeltas[direction].dx
+func findNextMazeNode(maze Maze, start MazePoint, direction geometry.Direction) (*MazePoint, int) {
+	position := start.Point
 	distance := 0
 
 	// traverse the graph in the provided direction until a Node is reached
 	for {
-		x, y = x+dx, y+dy // dx changes columns, dy changes rows
+		position = position.Move(direction)
 		distance++
 
-		// out of bounds
-		if y < 0 || y >= len(maze) || x < 0 || x >= len(maze[y]) {
+		// out of bounds or hit a wall - stop
+		if val, ok := maze.Get(position.X, position.Y); !ok || val == '#' {
 			return nil, 0
 		}
 
-		// hit a wall - stop
-		if maze[y][x].val == '#' {
-			return nil, 0
-		}
-
-		current := MazePoint{X: x, Y: y}
+		current := MazePoint{Point: position}
 
 		// Stop if reaching a node
 		if isMazeNode(maze, current) {
@@ -135,8 +114,7 @@ func findNextMazeNode(maze Maze, start MazePoint, direction int) (*MazePoint, in
 // IsNode checks if the current point in the graph is a node (intersection, corner,
 // endpoint, or dead end)
 func isMazeNode(maze Maze, point MazePoint) bool {
-	x, y := point.X, point.Y
-	cell := maze[y][x].val
+	cell := maze.At(point.X, point.Y)
 
 	// treat start or end as nodes
 	if cell == 'S' || cell == 'E' {
@@ -144,12 +122,9 @@ func isMazeNode(maze Maze, point MazePoint) bool {
 	}
 
 	var openPositions []MazePoint
-	for _, delta := range directionDeltas {
-		ny, nx := y+delta.dy, x+delta.dx
-		if ny >= 0 && ny < len(maze) && nx >= 0 && nx < len(maze[ny]) {
-			if maze[ny][nx].val != '#' {
-				openPositions = append(openPositions, MazePoint{X: nx, Y: ny})
-			}
+	for _, neighbor := range maze.Neighbours(point.X, point.Y) {
+		if maze.At(neighbor.X, neighbor.Y) != '#' {
+			openPositions = append(openPositions, MazePoint{Point: neighbor})
 		}
 	}
 
@@ -179,12 +154,12 @@ func isMazeNode(maze Maze, point MazePoint) bool {
 // - startDirection determines which direction from the starting point the traversal will begin
 //
 // The return value is the cost of the path that was found.
-func findLowestCostMazePath(graph MazeGraph, start, end MazePoint, startDirection int, calculateCost func(s *State, e *MazeEdge) int) int {
+func findLowestCostMazePath(graph MazeGraph, start, end MazePoint, startDirection geometry.Direction, calculateCost func(s *State, e *MazeEdge) int) int {
 	pq := &PriorityQueue{}
 	heap.Init(pq)
 
 	// store minimum costs to each node from each direction
-	visited := make(map[MazePoint]map[int]int)
+	visited := make(map[MazePoint]map[geometry.Direction]int)
 
 	// initialize the priority queue with the start node and direction
 	heap.Push(pq, &State{
@@ -204,7 +179,7 @@ func findLowestCostMazePath(graph MazeGraph, start, end MazePoint, startDirectio
 
 		// Check if we've seen a better cost for this node and direction
 		if visited[current.node.point] == nil {
-			visited[current.node.point] = make(map[int]int)
+			visited[current.node.point] = make(map[geometry.Direction]int)
 		}
 		if costSoFar, ok := visited[current.node.point][current.direction]; ok && costSoFar <= current.cost {
 			// we found a cheaper cost before, skip this one
@@ -245,17 +220,17 @@ func findLowestCostMazePath(graph MazeGraph, start, end MazePoint, startDirectio
 // - startDirection determines which direction from the starting point the traversal will begin
 //
 // The return value is the cost of the path that was found and
-func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirection int, calculateCost func(s *State, e *MazeEdge) int) (int, [][]MazePoint) {
+func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirection geometry.Direction, calculateCost func(s *State, e *MazeEdge) int) (int, [][]MazePoint) {
 	pq := &PriorityQueue{}
 	heap.Init(pq)
 
 	// visited[node][direction] = minimal cost to reach that node with that direction
-	visited := make(map[MazePoint]map[int]int)
+	visited := make(map[MazePoint]map[geometry.Direction]int)
 
 	// parents[node][direction] = list of (node,direction) from which we arrived at this node/direction at minimal cost
-	parents := make(map[MazePoint]map[int][]struct {
+	parents := make(map[MazePoint]map[geometry.Direction][]struct {
 		node      MazePoint
-		direction int
+		direction geometry.Direction
 	})
 
 	// Initialize with the start node and direction
@@ -266,7 +241,7 @@ func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirecti
 	})
 	// We know the cost to reach start with startDirection is 0
 	if visited[start] == nil {
-		visited[start] = make(map[int]int)
+		visited[start] = make(map[geometry.Direction]int)
 	}
 	visited[start][startDirection] = 0
 
@@ -311,7 +286,7 @@ func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirecti
 
 			// Check if this new path to edge.to.node & edge.direction is better or equal to previously known paths
 			if visited[edge.to.point] == nil {
-				visited[edge.to.point] = make(map[int]int)
+				visited[edge.to.point] = make(map[geometry.Direction]int)
 			}
 
 			prevCost, found := visited[edge.to.point][edge.direction]
@@ -320,15 +295,15 @@ func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirecti
 				visited[edge.to.point][edge.direction] = newCost
 
 				if parents[edge.to.point] == nil {
-					parents[edge.to.point] = make(map[int][]struct {
+					parents[edge.to.point] = make(map[geometry.Direction][]struct {
 						node      MazePoint
-						direction int
+						direction geometry.Direction
 					})
 				}
 				// Reset parents for this state because we found a strictly better path
 				parents[edge.to.point][edge.direction] = []struct {
 					node      MazePoint
-					direction int
+					direction geometry.Direction
 				}{
 					{current.node.point, current.direction},
 				}
@@ -344,7 +319,7 @@ func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirecti
 					parents[edge.to.point][edge.direction],
 					struct {
 						node      MazePoint
-						direction int
+						direction geometry.Direction
 					}{current.node.point, current.direction},
 				)
 				// No need to push to pq because this cost is already known
@@ -365,11 +340,11 @@ func findAllMinimumMazePaths(graph MazeGraph, start, end MazePoint, startDirecti
 
 // reconstructAllMazePaths reconstructs all of the minimal paths from the parents map
 func reconstructAllMazePaths(
-	parents map[MazePoint]map[int][]struct {
+	parents map[MazePoint]map[geometry.Direction][]struct {
 		node      MazePoint
-		direction int
+		direction geometry.Direction
 	},
-	visited map[MazePoint]map[int]int,
+	visited map[MazePoint]map[geometry.Direction]int,
 	end MazePoint,
 	minCost int,
 ) [][]MazePoint {
@@ -387,12 +362,12 @@ func reconstructAllMazePaths(
 
 // reconstructMemoryMazePaths finds the paths recursively from the parents map
 func reconstructMemoryMazePaths(
-	parents map[MazePoint]map[int][]struct {
+	parents map[MazePoint]map[geometry.Direction][]struct {
 		node      MazePoint
-		direction int
+		direction geometry.Direction
 	},
 	current MazePoint,
-	currentDirection int,
+	currentDirection geometry.Direction,
 ) [][]MazePoint {
 	// If no parents, this might be the start node
 	if parents[current] == nil || len(parents[current][currentDirection]) == 0 {
@@ -436,15 +411,12 @@ func expandAllMazePaths(allPaths [][]MazePoint, graph MazeGraph) [][]MazePoint {
 
 			// We have startNode and know edge.direction and edge.cost
 			// Let's expand intermediate cells
-			curX, curY := startNode.X, startNode.Y
-			dx := directionDeltas[edge.direction].dx
-			dy := directionDeltas[edge.direction].dy
+			current := startNode.Point
 
 			for step := 0; step < edge.cost; step++ {
-				curX += dx
-				curY += dy
+				current = current.Move(edge.direction)
 				pointCost := edge.cost + step + 1
-				expandedPath = append(expandedPath, MazePoint{X: curX, Y: curY, pointCost: pointCost})
+				expandedPath = append(expandedPath, MazePoint{Point: current, pointCost: pointCost})
 			}
 		}
 
@@ -477,12 +449,12 @@ func findMazeEdge(graph MazeGraph, from, to MazePoint) *MazeEdge {
 // - calculateCost is a function to compute the cost of moving from one state to the next
 //
 // The return value is a slice of MazePoint representing the path, or nil if no path is found.
-func findBestMazePath(graph MazeGraph, start, end MazePoint, startDirection int, calculateCost func(s *State, e *MazeEdge) int) *MazePath {
+func findBestMazePath(graph MazeGraph, start, end MazePoint, startDirection geometry.Direction, calculateCost func(s *State, e *MazeEdge) int) *MazePath {
 	pq := &PriorityQueue{}
 	heap.Init(pq)
 
 	// store minimum costs to each node from each direction
-	visited := make(map[MazePoint]map[int]int)
+	visited := make(map[MazePoint]map[geometry.Direction]int)
 
 	// initialize the priority queue with the start node and direction
 	heap.Push(pq, &State{
@@ -506,7 +478,7 @@ func findBestMazePath(graph MazeGraph, start, end MazePoint, startDirection int,
 
 		// Check if we've seen a better cost for this node and direction
 		if visited[current.node.point] == nil {
-			visited[current.node.point] = make(map[int]int)
+			visited[current.node.point] = make(map[geometry.Direction]int)
 		}
 		if costSoFar, ok := visited[current.node.point][current.direction]; ok && costSoFar <= current.cost {
 			// we found a cheaper cost before, skip this one
@@ -559,37 +531,39 @@ func findBestMazePath(graph MazeGraph, start, end MazePoint, startDirection int,
 	return &path
 }
 
-// findLocation will find the y,x location of the specified val in the specified
-// Maze
-func (maze *Maze) findLocation(val rune) MazePoint {
-	r := *maze
-	for y := 0; y < len(r); y++ {
-		for x := 0; x < len(r[0]); x++ {
-			if r[y][x].val == val {
-				return MazePoint{Y: y, X: x}
-			}
-		}
-	}
+// newMaze returns a maze of the specified size with every position open (.)
+func newMaze(width, height int) Maze {
+	maze := Maze{grid.New[rune](width, height)}
+	maze.Fill('.')
 
-	return MazePoint{Y: -1, X: -1}
+	return maze
 }
 
-// Print prints the current maze to stdout
-func (maze *Maze) Print() {
-	m := *maze
-	if len(m) == 0 || len((m)[0]) == 0 {
-		return
+// parseMaze converts the input into a Maze. An error is returned if the input isn't a
+// grid or is missing the start (S) or end (E) position.
+func parseMaze(input []string) (Maze, error) {
+	g, err := grid.Parse(input)
+	if err != nil {
+		return Maze{}, err
 	}
 
-	height := len(m)
-	width := len((m)[0])
-
-	for y := 0; y < height; y++ {
-		for x := 0; x < width; x++ {
-			fmt.Printf("%c", m[y][x].val)
+	maze := Maze{g}
+	for _, val := range []rune{'S', 'E'} {
+		if location := maze.findLocation(val); location.Y < 0 {
+			return Maze{}, fmt.Errorf("the maze is missing the %c position", val)
 		}
-		fmt.Printf("\n")
 	}
 
-	fmt.Printf("\n")
+	return maze, nil
+}
+
+// findLocation will find the y,x location of the specified val in the specified
+// Maze
+func (maze Maze) findLocation(val rune) MazePoint {
+	position, found := maze.Find(grid.Equal(val))
+	if !found {
+		return MazePoint{Point: geometry.Point{X: -1, Y: -1}}
+	}
+
+	return MazePoint{Point: position}
 }
@@ -2,11 +2,9 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"sort"
-	"strconv"
-	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -17,69 +15,77 @@ type Day1 struct {
 	file string
 }
 
+// init registers the Day 1 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 1, Title: "Historian Hysteria", File: "data/2024/day1/input.txt"}, func(name, file string) Exercise {
+		return &Day1{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 1 exercise
 func (d *Day1) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 1 exercise
+func (d *Day1) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 1 by retrieving the default file contents and uses that data
-func (d *Day1) Run(w io.Writer) {
+func (d *Day1) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 1 solution using the provided input data
-func (d *Day1) RunFromInput(w io.Writer, input []string) {
+// RunFromInput executes the Day 1 solution using the provided input data and returns the answers
+func (d *Day1) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 1 solution using the provided input data and
+// returns the answer
+func (d *Day1) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	// parse the input into two arrays
 	left, right, err := d.parseIntoLists(input)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	sumDifferences := d.Part1(left, right)
-
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 1 - Part 1 - The sum of the distances between the left and right list is %d.\n", sumDifferences)))
-
-	sumSimilarityScores := d.Part2(left, right)
+	switch part {
+	case 1:
+		sumDifferences := d.Part1(left, right)
+		return Answer{Part: 1, Label: "The sum of the distances between the left and right list", Value: sumDifferences}, nil
+	case 2:
+		sumSimilarityScores := d.Part2(left, right)
+		return Answer{Part: 2, Label: "The sum of the similarity scores between the left and right lists", Value: sumSimilarityScores}, nil
+	}
 
-	// part 2
-	w.Write([]byte(fmt.Sprintf("Day 1 - Part 2 - The sum of the similarity scores between the left and right lists is %d.\n", sumSimilarityScores)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // parseIntoLists parses the string input into two integer slices. An error is returned
 // if there was an error during the parsing effort.
 func (d *Day1) parseIntoLists(input []string) ([]int, []int, error) {
-	var left, right []int
-
-	// Parse the integers from the strings and populate left and right slices
-	for rowNumber, line := range input {
-		// Split the line into parts
-		parts := strings.Fields(line)
-		if len(parts) == 2 {
-			// Convert strings to integers
-			l, errL := strconv.Atoi(parts[0])
-			r, errR := strconv.Atoi(parts[1])
-			if errL == nil && errR == nil {
-				left = append(left, l)
-				right = append(right, r)
-			} else {
-				return nil, nil, fmt.Errorf("There was an error parsing the input on row %d: l(%v), r(%v)", rowNumber, errL, errR)
-			}
-		} else {
-			return nil, nil, fmt.Errorf("There was an error parsing the input on row %d: invalid format", rowNumber)
-		}
+	// each line is a pair of integers: one for the left list and one for the right
+	pairs, err := fileprocessing.ParseInts(input, "", 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	left := make([]int, 0, len(pairs))
+	right := make([]int, 0, len(pairs))
+	for _, pair := range pairs {
+		left = append(left, pair[0])
+		right = append(right, pair[1])
 	}
 
 	return left, right, nil
@@ -2,11 +2,12 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"strconv"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -15,63 +16,75 @@ type (
 		name string
 		file string
 	}
-
-	TopographicMap [][]int
-
-	MapPosition struct {
-		x, y, value int
-	}
 )
 
+// init registers the Day 10 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 10, Title: "Hoof It", File: "data/2024/day10/input.txt"}, func(name, file string) Exercise {
+		return &Day10{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 10 exercise
 func (d *Day10) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 10 exercise
+func (d *Day10) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 10 by retrieving the default file contents and uses that data
-func (d *Day10) Run(w io.Writer) {
+func (d *Day10) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 10 solution using the provided input data
-//
-// note - part1 and part2 could be combined to only iterate over the trails a single time and then
-// count the number of paths and unique paths to arrive at each solution. However, in the interest of separating
-// the solutions more deliberately, we navigate the trails for each part
-func (d *Day10) RunFromInput(w io.Writer, input []string) {
-	topo := d.parseInput(input)
-
-	// part 1
-	sumTrailheadScores := d.Part1(topo)
-	w.Write([]byte(fmt.Sprintf("Day 10 - Part 1 - The sum of the trailhead scores for the provided map is %d.\n", sumTrailheadScores)))
-
-	// part 2
-	sumTrailheadRatings := d.Part2(topo)
-	w.Write([]byte(fmt.Sprintf("Day 10 - Part 2 - The sum of the trailhead ratings for the provided map is %d.\n", sumTrailheadRatings)))
+// RunFromInput executes the Day 10 solution using the provided input data and returns the answers
+func (d *Day10) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// Part1 counts all of the unique trails that have the same origin and endpoint
-func (d *Day10) Part1(topo TopographicMap) int {
-	if topo == nil || len(topo) == 0 {
-		fmt.Printf("The TopographicMap specified is invalid.")
+// RunPart executes the specified part of the Day 10 solution using the provided input data and
+// returns the answer
+func (d *Day10) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	topo, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		sumTrailheadScores := d.Part1(topo)
+		return Answer{Part: 1, Label: "The sum of the trailhead scores for the provided map", Value: sumTrailheadScores}, nil
+	case 2:
+		sumTrailheadRatings := d.Part2(topo)
+		return Answer{Part: 2, Label: "The sum of the trailhead ratings for the provided map", Value: sumTrailheadRatings}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
+}
+
+// Part1 counts all of the unique trails that have the same origin and endpoint. -1 is
+// returned if the map is empty.
+func (d *Day10) Part1(topo *grid.Grid[int]) int {
+	if topo.Height() == 0 {
 		return -1
 	}
 
 	trailExit := 9
 	sumTrails := 0
 
-	trailheads := d.getTopographicMapPositions(topo, 0)
+	trailheads := topo.FindAll(grid.Equal(0))
 	for _, trailhead := range trailheads {
 		trails := d.findNextPosition(topo, trailhead, trailExit)
 		uniqueTrails := d.countUniqueTrails(trails)
@@ -81,17 +94,17 @@ func (d *Day10) Part1(topo TopographicMap) int {
 	return sumTrails
 }
 
-// Part2 counts the sum of the unique trails that have their own unique path
-func (d *Day10) Part2(topo TopographicMap) int {
-	if topo == nil || len(topo) == 0 {
-		fmt.Printf("The TopographicMap specified is invalid.")
+// Part2 counts the sum of the unique trails that have their own unique path. -1 is
+// returned if the map is empty.
+func (d *Day10) Part2(topo *grid.Grid[int]) int {
+	if topo.Height() == 0 {
 		return -1
 	}
 
 	trailExit := 9
 	sumTrails := 0
 
-	trailheads := d.getTopographicMapPositions(topo, 0)
+	trailheads := topo.FindAll(grid.Equal(0))
 	for _, trailhead := range trailheads {
 		trails := d.findNextPosition(topo, trailhead, trailExit)
 		sumTrails += len(trails)
@@ -103,20 +116,15 @@ func (d *Day10) Part2(topo TopographicMap) int {
 // findNextPosition navigates the map (going up, down, left, or right) from the
 // specified position until navigation is blocked (the next position is more than 1
 // topographical value away) or the exitVal is reached
-func (d *Day10) findNextPosition(topo TopographicMap, position MapPosition, exitVal int) []MapPosition {
-	maxY := len(topo)
-	maxX := len(topo[0])
-
-	var lastPositions []MapPosition
-	position.value = topo[position.y][position.x]
+func (d *Day10) findNextPosition(topo *grid.Grid[int], position geometry.Point, exitVal int) []geometry.Point {
+	var lastPositions []geometry.Point
+	value := topo.At(position.X, position.Y)
 
-	adjacentPositions := d.findAdjacentPositions(position, maxY, maxX)
-	for _, adjacentPosition := range adjacentPositions {
-		currentValue := topo[adjacentPosition.y][adjacentPosition.x]
-		adjacentPosition.value = currentValue
+	for _, adjacentPosition := range topo.Neighbours(position.X, position.Y) {
+		currentValue := topo.At(adjacentPosition.X, adjacentPosition.Y)
 
 		// Check if this adjacent position advances by exactly 1
-		if currentValue == position.value+1 {
+		if currentValue == value+1 {
 			if currentValue >= exitVal {
 				// append to results and continue
 				lastPositions = append(lastPositions, adjacentPosition)
@@ -130,95 +138,34 @@ func (d *Day10) findNextPosition(topo TopographicMap, position MapPosition, exit
 	return lastPositions
 }
 
-// countUniqueTrails counts the number of MapPosition in the specified array that have unique locations
-func (d *Day10) countUniqueTrails(trails []MapPosition) int {
-	uniquePositions := make(map[string]struct{})
+// countUniqueTrails counts the number of positions in the specified array that are unique
+func (d *Day10) countUniqueTrails(trails []geometry.Point) int {
+	uniquePositions := make(map[geometry.Point]struct{})
 
 	for _, trail := range trails {
-		key := fmt.Sprintf("%d,%d", trail.x, trail.y)
-		uniquePositions[key] = struct{}{}
+		uniquePositions[trail] = struct{}{}
 	}
 
 	return len(uniquePositions)
 }
 
-// findAdjacentPositions finds all of the possible positions that can be moved to from
-// the specified position. maxY and maxX define the boundaries of the map. Movement is
-// restricted to up, down, left, and right
-func (d *Day10) findAdjacentPositions(position MapPosition, maxY int, maxX int) []MapPosition {
-	// Define the possible directions for adjacency (including diagonals)
-	directions := []struct{ dx, dy int }{
-		{0, -1}, // Up
-		{-1, 0}, // Left
-		{1, 0},  // Right
-		{0, 1},  // Down
-	}
-
-	var adjacentPositions []MapPosition
-
-	for _, dir := range directions {
-		// Compute the new position
-		newX := position.x + dir.dx
-		newY := position.y + dir.dy
-
-		// Check if the new position is within bounds
-		if newX >= 0 && newX < maxX && newY >= 0 && newY < maxY {
-			// Add the new position with value -1
-			adjacentPositions = append(adjacentPositions, MapPosition{
-				x:     newX,
-				y:     newY,
-				value: -1, // Set the value to -1 as required
-			})
-		}
-	}
-
-	return adjacentPositions
-}
-
-// getTopographicMapPositions returns an array of MapPosition objects outlining all of the
-// TopographicalMap coordinates where the specified value exists
-func (d *Day10) getTopographicMapPositions(topo TopographicMap, value int) []MapPosition {
-	numColumns := len(topo)
-	if numColumns == 0 {
-		return nil
-	}
-
-	numRows := len(topo[0])
-
-	var positions []MapPosition
-	for y := 0; y < numRows; y++ {
-		for x := 0; x < numColumns; x++ {
-			if topo[y][x] == value {
-				positions = append(positions, MapPosition{x: x, y: y, value: value})
-			}
+// parseInput parses the input array of strings into a topographic map. An error is returned
+// if the input isn't a grid of digits.
+func (d *Day10) parseInput(input []string) (*grid.Grid[int], error) {
+	topo, err := grid.ParseFunc(input, func(r rune) (int, error) {
+		if r < '0' || r > '9' {
+			return 0, fmt.Errorf("invalid topographic value %q", r)
 		}
-	}
-
-	return positions
-}
 
-// parseInput parses the input array of strings into a TopoGraphicMap
-func (d *Day10) parseInput(input []string) TopographicMap {
-	numColumns := len(input)
-	if numColumns == 0 {
-		return nil
+		return int(r - '0'), nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	numRows := len(input[0])
-
-	var err error
-
-	var topo TopographicMap = make(TopographicMap, numRows)
-	for y := 0; y < numRows; y++ {
-		topo[y] = make([]int, numColumns)
-		for x := 0; x < numColumns; x++ {
-			topo[y][x], err = strconv.Atoi(string(input[y][x]))
-			if err != nil {
-				fmt.Printf("There was an error parsing converting the input character at [y:%d][x:%d] to its topographic value: %s: %v", y, x, string(input[y][x]), err)
-				return nil
-			}
-		}
+	if topo.Height() != topo.Width() {
+		return nil, fmt.Errorf("the map must be square, got %d rows of %d columns", topo.Height(), topo.Width())
 	}
 
-	return topo
+	return topo, nil
 }
@@ -17,7 +17,11 @@ func TestDay10Part1Simple(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part1(topo)
 	expectedValue := 2
@@ -40,7 +44,11 @@ func TestDay10Part1Simple2(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part1(topo)
 	expectedValue := 4
@@ -63,7 +71,11 @@ func TestDay10Part1Simple3(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part1(topo)
 	expectedValue := 3
@@ -87,7 +99,11 @@ func TestDay10Part1(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part1(topo)
 	expectedValue := 36
@@ -110,7 +126,11 @@ func TestDay10Part2Simple(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part2(topo)
 	expectedValue := 3
@@ -133,7 +153,11 @@ func TestDay10Part2Simple2(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part2(topo)
 	expectedValue := 13
@@ -155,7 +179,11 @@ func TestDay10Part2Simple3(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part2(topo)
 	expectedValue := 227
@@ -179,7 +207,11 @@ func TestDay10Part2(t *testing.T) {
 
 	d10 := Day10{}
 
-	topo := d10.parseInput(input)
+	topo, err := d10.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d10.Part2(topo)
 	expectedValue := 81
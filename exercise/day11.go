@@ -2,9 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
 	"strconv"
 	"strings"
 
@@ -19,44 +18,66 @@ type (
 	}
 )
 
+// init registers the Day 11 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 11, Title: "Plutonian Pebbles", File: "data/2024/day11/input.txt"}, func(name, file string) Exercise {
+		return &Day11{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the day 11 exercise
 func (d *Day11) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the day 11 exercise
+func (d *Day11) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for day 11 by retrieving the default file contents and uses that data
-func (d *Day11) Run(w io.Writer) {
+func (d *Day11) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day 11 solution using the provided input data and returns the answers
+func (d *Day11) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// RunFromInput executs the day 11 solution using the provided input data
-func (d *Day11) RunFromInput(w io.Writer, input []string) {
+// RunPart executes the specified part of the Day 11 solution using the provided input data and
+// returns the answer
+func (d *Day11) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	if len(input) != 1 {
-		log.Fatalf("the input is invalid")
+		return Answer{Part: part}, fmt.Errorf("the input is invalid: expected 1 line, got %d", len(input))
 	}
 
-	stones := d.parseInput(input[0])
+	stones, err := d.parseInput(input[0])
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 1
-	numBlinks := 25
-	numStones := d.ProcessStones(stones, numBlinks)
-	w.Write([]byte(fmt.Sprintf("Day 11 - Part 1 - The number of stones after %d blinks is %d.\n", numBlinks, numStones)))
+	switch part {
+	case 1:
+		numBlinks := 25
+		numStones := d.ProcessStones(stones, numBlinks)
+		return Answer{Part: 1, Label: fmt.Sprintf("The number of stones after %d blinks", numBlinks), Value: numStones}, nil
+	case 2:
+		numBlinks := 75
+		numStones := d.ProcessStones(stones, numBlinks)
+		return Answer{Part: 2, Label: fmt.Sprintf("The number of stones after %d blinks", numBlinks), Value: numStones}, nil
+	}
 
-	// part 1
-	numBlinks = 75
-	numStones = d.ProcessStones(stones, numBlinks)
-	w.Write([]byte(fmt.Sprintf("Day 11 - Part 2 - The number of stones after %d blinks is %d.\n", numBlinks, numStones)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // ProcessStones applies the rules of a 'blink' (per the day's assignment) and determines the number of
@@ -107,19 +128,24 @@ func (d *Day11) ProcessStones(input []uint64, blinks int) uint64 {
 	return numStones
 }
 
-// parseInput parses the specified input into a slice of string values by separating
-// the input by a space
-func (d *Day11) parseInput(input string) []uint64 {
+// parseInput parses the specified input into a slice of stone values by separating
+// the input by whitespace. An error is returned if a stone isn't a number.
+func (d *Day11) parseInput(input string) ([]uint64, error) {
 	var iStones []uint64
 
-	stones := strings.Split(input, " ")
+	stones := strings.Fields(input)
+	if len(stones) == 0 {
+		return nil, fileprocessing.NewParseError(1, input, fmt.Errorf("there are no stones"))
+	}
+
 	for _, stone := range stones {
 		iStone, err := strconv.ParseUint(stone, 10, 64)
 		if err != nil {
-			fmt.Printf("could not convert %s", stone)
+			return nil, fileprocessing.NewParseError(1, input, err)
 		}
+
 		iStones = append(iStones, iStone)
 	}
 
-	return iStones
+	return iStones, nil
 }
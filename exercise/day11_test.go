@@ -9,7 +9,11 @@ func TestDay11(t *testing.T) {
 
 	d11 := Day11{}
 
-	stones := d11.parseInput(input)
+	stones, err := d11.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	blinks := 6
 	calculatedValue := d11.ProcessStones(stones, blinks)
@@ -2,10 +2,12 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -15,9 +17,8 @@ type (
 		file string
 	}
 
-	Garden     [][]GardenNode
 	GardenNode struct {
-		row, col              int
+		geometry.Point
 		left, up, right, down bool
 		val                   rune
 	}
@@ -31,52 +32,74 @@ type (
 	}
 )
 
+// init registers the Day 12 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 12, Title: "Garden Groups", File: "data/2024/day12/input.txt"}, func(name, file string) Exercise {
+		return &Day12{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 12 exercise
 func (d *Day12) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 12 exercise
+func (d *Day12) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 12 by retrieving the default file contents and uses that data
-func (d *Day12) Run(w io.Writer) {
+func (d *Day12) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 12 solution using the provided input data
-func (d *Day12) RunFromInput(w io.Writer, input []string) {
-	garden := d.parseInput(input)
+// RunFromInput executes the Day 12 solution using the provided input data and returns the answers
+func (d *Day12) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	// part 1
-	totalPrice := d.Part1(garden)
-	w.Write([]byte(fmt.Sprintf("Day 12 - Part 1 - The total price of fencing all regions is %d.\n", totalPrice)))
+// RunPart executes the specified part of the Day 12 solution using the provided input data and
+// returns the answer
+func (d *Day12) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	garden, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 1
-	totalPrice = d.Part2(garden)
-	w.Write([]byte(fmt.Sprintf("Day 12 - Part 2 - The total price of fencing all regions is %d.\n", totalPrice)))
+	switch part {
+	case 1:
+		totalPrice := d.Part1(garden)
+		return Answer{Part: 1, Label: "The total price of fencing all regions", Value: totalPrice}, nil
+	case 2:
+		totalPrice := d.Part2(garden)
+		return Answer{Part: 2, Label: "The total price of fencing all regions (with bulk discount)", Value: totalPrice}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 calculates the price of the fence by finding each Garden section, calculating
 // the area, calculating the perimeter, multiplying the area * perimeter, and adding the
 // product. The sum of the products for each area is the total price of the fencing,
 // per the assignment.
-func (d *Day12) Part1(garden Garden) int {
+func (d *Day12) Part1(garden *grid.Grid[rune]) int {
 	sections := d.extractSections(garden)
 	var sectionDetails []GardenSectionDetails
 
 	for coordinate, section := range sections {
 		details := GardenSectionDetails{
 			id:    coordinate,
-			plant: garden[coordinate.row][coordinate.col].val,
+			plant: garden.At(coordinate.X, coordinate.Y),
 			area:  len(section),
 		}
 
@@ -96,14 +119,14 @@ func (d *Day12) Part1(garden Garden) int {
 // Part2 calculates the price of fence by calculating the area of a section
 // and the number of straight fence runs in that section (which corresponds to
 // the number of corners)
-func (d *Day12) Part2(garden Garden) int {
+func (d *Day12) Part2(garden *grid.Grid[rune]) int {
 	sections := d.extractSections(garden)
 	var sectionDetails []GardenSectionDetails
 
 	for coordinate, section := range sections {
 		details := GardenSectionDetails{
 			id:    coordinate,
-			plant: garden[coordinate.row][coordinate.col].val,
+			plant: garden.At(coordinate.X, coordinate.Y),
 			area:  len(section),
 		}
 
@@ -121,38 +144,17 @@ func (d *Day12) Part2(garden Garden) int {
 	return price
 }
 
-// parseInput parses the file input into a Garden struct
-func (d *Day12) parseInput(input []string) Garden {
-	if len(input) == 0 {
-		return nil
-	}
-
-	rows := len(input)
-	cols := len(input[0])
-
-	gardenNodes := make(Garden, rows)
-	for i := 0; i < rows; i++ {
-		gardenNodes[i] = make([]GardenNode, cols)
-		for j, char := range input[i] {
-			gardenNodes[i][j].val = char
-			gardenNodes[i][j].row = i
-			gardenNodes[i][j].col = j
-		}
-	}
-
-	return gardenNodes
-}
-
-// Helper function to check if a coordinate is within bounds
-func (d *Day12) isInBounds(garden Garden, row, col int) bool {
-	return row >= 0 && row < len(garden) && col >= 0 && col < len(garden[0])
+// parseInput parses the file input into a grid of the plants of the garden. An error is
+// returned if the input isn't a grid.
+func (d *Day12) parseInput(input []string) (*grid.Grid[rune], error) {
+	return grid.Parse(input)
 }
 
 // findSection finds sections using flood-fill
-func (d *Day12) findSection(garden Garden, visited map[GardenNode]bool, row, col int) []GardenNode {
-	runeValue := garden[row][col].val
+func (d *Day12) findSection(garden *grid.Grid[rune], visited map[GardenNode]bool, start geometry.Point) []GardenNode {
+	runeValue := garden.At(start.X, start.Y)
 	section := []GardenNode{}
-	stack := []GardenNode{{row: row, col: col}}
+	stack := []GardenNode{{Point: start}}
 
 	// Perform DFS to collect all connected coordinates
 	for len(stack) > 0 {
@@ -168,17 +170,10 @@ func (d *Day12) findSection(garden Garden, visited map[GardenNode]bool, row, col
 		section = append(section, coord)
 
 		// check neighbors
-		directions := []GardenNode{
-			{row: 0, col: 1},  // right
-			{row: 1, col: 0},  // down
-			{row: 0, col: -1}, // left
-			{row: -1, col: 0}, // up
-		}
-
-		for _, dir := range directions {
-			nrow, ncol := coord.row+dir.row, coord.col+dir.col
-			if d.isInBounds(garden, nrow, ncol) && !visited[GardenNode{row: nrow, col: ncol}] && garden[nrow][ncol].val == runeValue {
-				stack = append(stack, GardenNode{row: nrow, col: ncol})
+		for _, neighbor := range garden.Neighbours(coord.X, coord.Y) {
+			next := GardenNode{Point: neighbor}
+			if !visited[next] && garden.At(neighbor.X, neighbor.Y) == runeValue {
+				stack = append(stack, next)
 			}
 		}
 	}
@@ -186,28 +181,26 @@ func (d *Day12) findSection(garden Garden, visited map[GardenNode]bool, row, col
 	return section
 }
 
-// extractSections creates an instance of GardenSections from the specified Garden
-func (d *Day12) extractSections(garden Garden) GardenSections {
+// extractSections creates an instance of GardenSections from the specified garden
+func (d *Day12) extractSections(garden *grid.Grid[rune]) GardenSections {
 	sections := make(GardenSections)
 	visited := make(map[GardenNode]bool)
 
-	for row := 0; row < len(garden); row++ {
-		for col := 0; col < len(garden[0]); col++ {
-			coord := GardenNode{row: row, col: col}
+	for position := range garden.All() {
+		coord := GardenNode{Point: position}
 
-			// If not visited, it's a new section
-			if !visited[coord] {
-				section := d.findSection(garden, visited, row, col)
-				sections[coord] = section
-			}
+		// If not visited, it's a new section
+		if !visited[coord] {
+			section := d.findSection(garden, visited, position)
+			sections[coord] = section
 		}
 	}
 
 	return sections
 }
 
-// calculatePerimeter calculates the perimeter of a given Garden section using
-func (d *Day12) calculatePerimeter(garden Garden, section []GardenNode) int {
+// calculatePerimeter calculates the perimeter of a given garden section
+func (d *Day12) calculatePerimeter(garden *grid.Grid[rune], section []GardenNode) int {
 	// set used to check if a coordinate belongs to the section
 	sectionSet := make(map[GardenNode]bool)
 	for _, coord := range section {
@@ -216,17 +209,10 @@ func (d *Day12) calculatePerimeter(garden Garden, section []GardenNode) int {
 
 	perimeter := 0
 
-	directions := []GardenNode{
-		{row: 0, col: 1},  // right
-		{row: 1, col: 0},  // down
-		{row: 0, col: -1}, // left
-		{row: -1, col: 0}, // up
-	}
-
 	for _, coord := range section {
-		for _, dir := range directions {
-			neighbor := GardenNode{row: coord.row + dir.row, col: coord.col + dir.col}
-			if !d.isInBounds(garden, neighbor.row, neighbor.col) || !sectionSet[neighbor] {
+		for _, direction := range geometry.Directions {
+			neighbor := GardenNode{Point: coord.Move(direction)}
+			if !garden.InBounds(neighbor.X, neighbor.Y) || !sectionSet[neighbor] {
 				// neighbor is not out of bounds and is part of the section
 				perimeter++
 			}
@@ -242,11 +228,11 @@ func (d *Day12) calculatePerimeter(garden Garden, section []GardenNode) int {
 func (d *Day12) countCorners(section []GardenNode) int {
 	var numCorners int
 
-	// Create a lookup map for quick node access by (row,col)
-	nodeMap := make(map[[2]int]*GardenNode)
+	// Create a lookup map for quick node access by position
+	nodeMap := make(map[geometry.Point]*GardenNode)
 	for i := range section {
 		n := &section[i]
-		nodeMap[[2]int{n.row, n.col}] = n
+		nodeMap[n.Point] = n
 	}
 
 	// count left corners
@@ -255,22 +241,16 @@ func (d *Day12) countCorners(section []GardenNode) int {
 			if node.up {
 				numCorners++
 			} else {
-				nextRow := node.row - 1
-				if nextRow >= 0 {
-					index := [2]int{nextRow, node.col}
-					upNode := nodeMap[index]
-					if upNode != nil && !upNode.left && upNode.val == node.val {
-						numCorners++
-					}
+				upNode := nodeMap[node.Move(geometry.North)]
+				if upNode != nil && !upNode.left && upNode.val == node.val {
+					numCorners++
 				}
 			}
 
 			if node.down {
 				numCorners++
 			} else {
-				nextRow := node.row + 1
-				index := [2]int{nextRow, node.col}
-				downNode := nodeMap[index]
+				downNode := nodeMap[node.Move(geometry.South)]
 				if downNode != nil && !downNode.left && downNode.val == node.val {
 					numCorners++
 				}
@@ -281,22 +261,16 @@ func (d *Day12) countCorners(section []GardenNode) int {
 			if node.up {
 				numCorners++
 			} else {
-				nextRow := node.row - 1
-				if nextRow >= 0 {
-					index := [2]int{nextRow, node.col}
-					upNode := nodeMap[index]
-					if upNode != nil && !upNode.right && upNode.val == node.val {
-						numCorners++
-					}
+				upNode := nodeMap[node.Move(geometry.North)]
+				if upNode != nil && !upNode.right && upNode.val == node.val {
+					numCorners++
 				}
 			}
 
 			if node.down {
 				numCorners++
 			} else {
-				nextRow := node.row + 1
-				index := [2]int{nextRow, node.col}
-				downNode := nodeMap[index]
+				downNode := nodeMap[node.Move(geometry.South)]
 				if downNode != nil && !downNode.right && downNode.val == node.val {
 					numCorners++
 				}
@@ -313,11 +287,11 @@ func (d *Day12) countVertices(section []GardenNode) int {
 	left := [][]GardenNode{}
 	right := [][]GardenNode{}
 
-	// Create a lookup map for quick node access by (row,col)
-	nodeMap := make(map[[2]int]*GardenNode)
+	// Create a lookup map for quick node access by position
+	nodeMap := make(map[geometry.Point]*GardenNode)
 	for i := range section {
 		n := &section[i]
-		nodeMap[[2]int{n.row, n.col}] = n
+		nodeMap[n.Point] = n
 	}
 
 	visitedUp := make(map[*GardenNode]bool)
@@ -353,13 +327,13 @@ func (d *Day12) countVertices(section []GardenNode) int {
 
 			// vertical connections: move up and down
 			// Up neighbor
-			upNeighborPos := [2]int{n.row - 1, n.col}
+			upNeighborPos := n.Move(geometry.North)
 			if nn, ok := nodeMap[upNeighborPos]; ok && !visited[nn] && dirFlag(nn) {
 				stack = append(stack, nn)
 			}
 
 			// Down neighbor
-			downNeighborPos := [2]int{n.row + 1, n.col}
+			downNeighborPos := n.Move(geometry.South)
 			if nn, ok := nodeMap[downNeighborPos]; ok && !visited[nn] && dirFlag(nn) {
 				stack = append(stack, nn)
 			}
@@ -393,13 +367,13 @@ func (d *Day12) countVertices(section []GardenNode) int {
 
 			// horizontal connections: move left and right
 			// Left neighbor
-			leftNeighborPos := [2]int{n.row, n.col - 1}
+			leftNeighborPos := n.Move(geometry.West)
 			if nn, ok := nodeMap[leftNeighborPos]; ok && !visited[nn] && dirFlag(nn) {
 				stack = append(stack, nn)
 			}
 
 			// Right neighbor
-			rightNeighborPos := [2]int{n.row, n.col + 1}
+			rightNeighborPos := n.Move(geometry.East)
 			if nn, ok := nodeMap[rightNeighborPos]; ok && !visited[nn] && dirFlag(nn) {
 				stack = append(stack, nn)
 			}
@@ -444,7 +418,7 @@ func (d *Day12) countVertices(section []GardenNode) int {
 }
 
 // setDirectionalFlags sets the left, up, right, and down flags for each GardenCoordinate
-func (d *Day12) setDirectionalFlags(garden Garden, coordinates []GardenNode) []GardenNode {
+func (d *Day12) setDirectionalFlags(garden *grid.Grid[rune], coordinates []GardenNode) []GardenNode {
 	// Create a map for quick lookup of coordinates in the given slice
 	coordinateSet := make(map[GardenNode]bool)
 	for _, coord := range coordinates {
@@ -453,22 +427,22 @@ func (d *Day12) setDirectionalFlags(garden Garden, coordinates []GardenNode) []G
 
 	// Define directions and their corresponding flag names
 	directions := []struct {
-		drow, dcol int
-		flagName   string
-		setFlag    func(*GardenNode, bool)
+		direction geometry.Direction
+		flagName  string
+		setFlag   func(*GardenNode, bool)
 	}{
-		{-1, 0, "up", func(c *GardenNode, val bool) { c.up = val }},
-		{1, 0, "down", func(c *GardenNode, val bool) { c.down = val }},
-		{0, -1, "left", func(c *GardenNode, val bool) { c.left = val }},
-		{0, 1, "right", func(c *GardenNode, val bool) { c.right = val }},
+		{geometry.North, "up", func(c *GardenNode, val bool) { c.up = val }},
+		{geometry.South, "down", func(c *GardenNode, val bool) { c.down = val }},
+		{geometry.West, "left", func(c *GardenNode, val bool) { c.left = val }},
+		{geometry.East, "right", func(c *GardenNode, val bool) { c.right = val }},
 	}
 
 	// Iterate through each coordinate and set the directional flags
 	for i := range coordinates {
 		current := &coordinates[i]
 		for _, dir := range directions {
-			neighbor := GardenNode{row: current.row + dir.drow, col: current.col + dir.dcol}
-			if !d.isInBounds(garden, neighbor.row, neighbor.col) || !coordinateSet[neighbor] {
+			neighbor := GardenNode{Point: current.Move(dir.direction)}
+			if !garden.InBounds(neighbor.X, neighbor.Y) || !coordinateSet[neighbor] {
 				// Neighbor is out of bounds or not in the slice
 				dir.setFlag(current, true)
 			}
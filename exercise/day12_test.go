@@ -14,7 +14,11 @@ func TestDay12Part1Simple(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part1(garden)
 	expectedValue := 140
@@ -35,7 +39,11 @@ func TestDay12Part1Simple1(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part1(garden)
 	expectedValue := 772
@@ -61,7 +69,11 @@ func TestDay12Part1(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part1(garden)
 	expectedValue := 1930
@@ -81,7 +93,11 @@ func TestDay12Part2Simple(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part2(garden)
 	expectedValue := 80
@@ -102,7 +118,11 @@ func TestDay12Part2Simple1(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part2(garden)
 	expectedValue := 236
@@ -124,7 +144,11 @@ func TestDay12Part2Simple2(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part2(garden)
 	expectedValue := 368
@@ -150,7 +174,11 @@ func TestDay12Part2(t *testing.T) {
 
 	d12 := Day12{}
 
-	garden := d12.parseInput(input)
+	garden, err := d12.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d12.Part2(garden)
 	expectedValue := 1206
@@ -2,8 +2,9 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
+	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -28,40 +29,57 @@ type (
 	}
 )
 
+// init registers the Day 13 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 13, Title: "Claw Contraption", File: "data/2024/day13/input.txt"}, func(name, file string) Exercise {
+		return &Day13{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 13 exercise
 func (d *Day13) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 13 exercise
+func (d *Day13) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 13 by retrieving the default file contents and uses that data
-func (d *Day13) Run(w io.Writer) {
+func (d *Day13) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 13 solution using the provided input data
-func (d *Day13) RunFromInput(w io.Writer, input []string) {
-	var numPrizesWon, sumTokensSpent int64
+// RunFromInput executes the Day 13 solution using the provided input data and returns the answers
+func (d *Day13) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 13 solution using the provided input data and
+// returns the answer
+func (d *Day13) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	if part != 1 && part != 2 {
+		return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
+	}
 
-	// part 1
-	clawGames := d.parseInput(input, false)
-	numPrizesWon, sumTokensSpent = d.Solve(clawGames)
-	w.Write([]byte(fmt.Sprintf("Day 13 - Part 1 - prizes won: %d, tokens spent: %d\n", numPrizesWon, sumTokensSpent)))
+	// the prize locations are offset in part 2
+	clawGames, err := d.parseInput(input, part == 2)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 2
-	clawGames = d.parseInput(input, true)
-	numPrizesWon, sumTokensSpent = d.Solve(clawGames)
-	w.Write([]byte(fmt.Sprintf("Day 13 - Part 2 - prizes won: %d, tokens spent: %d\n", numPrizesWon, sumTokensSpent)))
+	numPrizesWon, sumTokensSpent := d.Solve(clawGames)
+	return Answer{Part: part, Label: fmt.Sprintf("The tokens spent to win %d prizes", numPrizesWon), Value: sumTokensSpent}, nil
 }
 
 // Solve uses Cramer's rule to identify the solve for each specified ClawGame instance
@@ -82,23 +100,32 @@ const (
 	priceB = 1
 )
 
-// parseInput parses the specified input into a slice of ClawGame instances
-func (d *Day13) parseInput(input []string, p2 bool) []ClawGame {
+// parseInput parses the specified input into a slice of ClawGame instances. An error is
+// returned if a game isn't made up of the two button lines and the prize line.
+func (d *Day13) parseInput(input []string, p2 bool) ([]ClawGame, error) {
 	var games []ClawGame
 
-	// Process every 3 lines, skipping the blank line
-	for i := 0; i < len(input); i += 4 {
-		// button A
-		var aX, aY int64
-		fmt.Sscanf(input[i], "Button A: X+%d, Y+%d", &aX, &aY)
+	// each game is a block of the two button lines and the prize line
+	for _, block := range fileprocessing.Blocks(input) {
+		if len(block.Lines) != 3 {
+			return nil, block.LineErr(0, fmt.Errorf("expected two button lines and a prize line"))
+		}
 
-		// button B
-		var bX, bY int64
-		fmt.Sscanf(input[i+1], "Button B: X+%d, Y+%d", &bX, &bY)
+		for i, prefix := range []string{"Button A:", "Button B:", "Prize:"} {
+			if !strings.HasPrefix(block.Lines[i], prefix) {
+				return nil, block.LineErr(i, fmt.Errorf("expected a line starting with %q", prefix))
+			}
+		}
 
-		// prize location
-		var pX, pY int64
-		fmt.Sscanf(input[i+2], "Prize: X=%d, Y=%d", &pX, &pY)
+		values, err := block.ExtractInts(2)
+		if err != nil {
+			return nil, err
+		}
+
+		// button A, button B and the prize location
+		aX, aY := int64(values[0][0]), int64(values[0][1])
+		bX, bY := int64(values[1][0]), int64(values[1][1])
+		pX, pY := int64(values[2][0]), int64(values[2][1])
 
 		if p2 {
 			pX += 10000000000000
@@ -111,10 +138,11 @@ func (d *Day13) parseInput(input []string, p2 bool) []ClawGame {
 			xPrizeLocation: pX,
 			yPrizeLocation: pY,
 		}
+
 		games = append(games, game)
 	}
 
-	return games
+	return games, nil
 }
 
 // solveUsingCramersRule solves for a game using Cramer's rule:
@@ -25,7 +25,11 @@ func TestDay13Part1(t *testing.T) {
 
 	d13 := Day13{}
 
-	games := d13.parseInput(input, false)
+	games, err := d13.parseInput(input, false)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	prizesWon, minimumTokensSpent := d13.Solve(games)
 	expectedPrizesWon := int64(2)
@@ -60,7 +64,11 @@ func TestDay13Part2(t *testing.T) {
 
 	d13 := Day13{}
 
-	games := d13.parseInput(input, true)
+	games, err := d13.parseInput(input, true)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	prizesWon, minimumTokensSpent := d13.Solve(games)
 	expectedPrizesWon := int64(2)
@@ -2,12 +2,14 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
+	"os"
 	"strconv"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -23,45 +25,68 @@ type (
 	}
 )
 
+// init registers the Day 14 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 14, Title: "Restroom Redoubt", File: "data/2024/day14/input.txt"}, func(name, file string) Exercise {
+		return &Day14{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 14 exercise
 func (d *Day14) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 14 exercise
+func (d *Day14) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 14 by retrieving the default file contents and uses that data
-func (d *Day14) Run(w io.Writer) {
+func (d *Day14) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 14 solution using the provided input data
-func (d *Day14) RunFromInput(w io.Writer, input []string) {
-	robots := d.parseInput(input)
+// RunFromInput executes the Day 14 solution using the provided input data and returns the answers
+func (d *Day14) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	var seconds, gridX, gridY int
+// RunPart executes the specified part of the Day 14 solution using the provided input data and
+// returns the answer
+func (d *Day14) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	robots, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 1
-	seconds = 100
-	gridX = 101
-	gridY = 103
-	safetyFactor := d.Part1(robots, seconds, gridX, gridY)
-	w.Write([]byte(fmt.Sprintf("Day 14 - Part 1 - The safety factor after %d seconds for a %d by %d grid is %d.\n", seconds, gridX, gridY, safetyFactor)))
+	gridX := 101
+	gridY := 103
+
+	switch part {
+	case 1:
+		seconds := 100
+		safetyFactor := d.Part1(robots, seconds, gridX, gridY)
+		return Answer{Part: 1, Label: fmt.Sprintf("The safety factor after %d seconds for a %d by %d grid", seconds, gridX, gridY), Value: safetyFactor}, nil
+	case 2:
+		secondsToTree, err := d.Part2(ctx, robots, gridX, gridY)
+		if err != nil {
+			return Answer{Part: 2}, err
+		}
 
-	// Part2
-	// need fresh input because the previous robots have been moved 100 seconds as part of Part 1
-	robots = d.parseInput(input)
-	secondsToTree := d.Part2(robots, gridX, gridY)
-	w.Write([]byte(fmt.Sprintf("Day 14 - Part 2 - The tree is visible after %d seconds.\n", secondsToTree)))
+		return Answer{Part: 2, Label: "The number of seconds until the tree is visible", Value: secondsToTree}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 determines where the robots will be after the specified number of seconds and calculates
@@ -139,7 +164,7 @@ func (d *Day14) moveRobots(robots []Robot, sizeX, sizeY int) {
 
 // countRobots counts the robots in the specified robots grid using the specified
 // boundaries
-func (d *Day14) countRobots(robots [][]int, startX, endX int, startY, endY int) int {
+func (d *Day14) countRobots(robots *grid.Grid[int], startX, endX int, startY, endY int) int {
 	if endY < startY || endX < startX {
 		return -1
 	}
@@ -147,48 +172,41 @@ func (d *Day14) countRobots(robots [][]int, startX, endX int, startY, endY int)
 	robotsInSpecifiedGrid := 0
 	for y := startY; y <= endY; y++ {
 		for x := startX; x <= endX; x++ {
-			robotsInSpecifiedGrid += robots[y][x]
+			robotsInSpecifiedGrid += robots.At(x, y)
 		}
 	}
 
 	return robotsInSpecifiedGrid
 }
 
-// robotsToGrid converts a slice of Robots into a [][]int grid that shows the count
-// of the robots at each grid location
-func (d *Day14) robotsToGrid(robots []Robot, sizeX, sizeY int) [][]int {
-	grid := make([][]int, sizeY) // Create a slice for the rows
-	for i := range grid {
-		grid[i] = make([]int, sizeX) // Allocate each row with sizeX columns
-	}
+// robotsToGrid converts a slice of Robots into a grid that shows the count of the robots
+// at each grid location
+func (d *Day14) robotsToGrid(robots []Robot, sizeX, sizeY int) *grid.Grid[int] {
+	robotMap := grid.New[int](sizeX, sizeY)
 
 	for _, robot := range robots {
-		x := robot.x
-		y := robot.y
-
-		grid[y][x]++
+		robotMap.Set(robot.x, robot.y, robotMap.At(robot.x, robot.y)+1)
 	}
 
-	return grid
+	return robotMap
 }
 
 // Part2 tries to find when the robots are arranged into a Christmas tree,
-// which should correlate to when all robots are on a distinct location
-func (d *Day14) Part2(robots []Robot, gridX, gridY int) int {
+// which should correlate to when all robots are on a distinct location. An error is
+// returned if the context is done before that happens.
+func (d *Day14) Part2(ctx context.Context, robots []Robot, gridX, gridY int) (int, error) {
 	seconds := 0
 	for {
+		// the robots may never all be in distinct locations, so stop if the context is done
+		if err := ctx.Err(); err != nil {
+			return seconds, err
+		}
+
 		seconds++
 		d.moveRobots(robots, gridX, gridY)
 		robotMap := d.robotsToGrid(robots, gridX, gridY)
 
-		overlap := false
-		for _, row := range robotMap {
-			for _, element := range row {
-				if element > 1 {
-					overlap = true
-				}
-			}
-		}
+		_, overlap := robotMap.Find(func(count int) bool { return count > 1 })
 
 		if !overlap {
 			// it turns out the Christmas tree is visible when the robots are all in a distinct location
@@ -200,54 +218,41 @@ func (d *Day14) Part2(robots []Robot, gridX, gridY int) int {
 		}
 	}
 
-	return seconds
+	return seconds, nil
 }
 
-// parseInput takes the specified input and returns a slice of Robot instances
-func (d *Day14) parseInput(input []string) []Robot {
+// parseInput takes the specified input and returns a slice of Robot instances. An error is
+// returned if a line isn't of the form p=x,y v=dx,dy.
+func (d *Day14) parseInput(input []string) ([]Robot, error) {
 	var robots []Robot
 
-	for _, line := range input {
-		parts := strings.Fields(line)
-		if len(parts) != 2 {
-			continue // Skip if the format is invalid
+	for i, line := range input {
+		if !strings.HasPrefix(line, "p=") || !strings.Contains(line, " v=") {
+			return nil, fileprocessing.NewParseError(i+1, line, fmt.Errorf("expected a robot of the form p=x,y v=dx,dy"))
 		}
+	}
 
-		// position (p=...)
-		posPart := strings.TrimPrefix(parts[0], "p=")
-		posCoords := strings.Split(posPart, ",")
-		if len(posCoords) != 2 {
-			continue
-		}
-		x, _ := strconv.Atoi(posCoords[0])
-		y, _ := strconv.Atoi(posCoords[1])
-
-		// velocity (v=...)
-		velPart := strings.TrimPrefix(parts[1], "v=")
-		velCoords := strings.Split(velPart, ",")
-		if len(velCoords) != 2 {
-			continue
-		}
-		velocityX, _ := strconv.Atoi(velCoords[0])
-		velocityY, _ := strconv.Atoi(velCoords[1])
+	// the position (p=x,y) and velocity (v=dx,dy) of each robot
+	values, err := fileprocessing.ExtractAllInts(input, 4)
+	if err != nil {
+		return nil, err
+	}
 
-		robot := Robot{x: x, y: y, velocityX: velocityX, velocityY: velocityY}
+	for _, v := range values {
+		robot := Robot{x: v[0], y: v[1], velocityX: v[2], velocityY: v[3]}
 		robots = append(robots, robot)
 	}
 
-	return robots
+	return robots, nil
 }
 
 // printGrid pretty-prints the grid to be able to visually identify the Christmas tree
-func (d *Day14) printGrid(robotMap [][]int) {
-	for _, row := range robotMap {
-		for _, element := range row {
-			if element == 0 {
-				fmt.Printf(".")
-			} else {
-				fmt.Printf("%d", element)
-			}
+func (d *Day14) printGrid(robotMap *grid.Grid[int]) {
+	robotMap.Render(os.Stdout, func(count int) string {
+		if count == 0 {
+			return "."
 		}
-		fmt.Printf("\n")
-	}
+
+		return strconv.Itoa(count)
+	})
 }
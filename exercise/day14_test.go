@@ -1,7 +1,10 @@
 package exercise
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestDay14Part1(t *testing.T) {
@@ -27,7 +30,11 @@ func TestDay14Part1(t *testing.T) {
 	gridX = 11
 	gridY = 7
 
-	robots := d14.parseInput(input)
+	robots, err := d14.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	safetyFactor := d14.Part1(robots, seconds, gridX, gridY)
 	expectedSafetyFactor := 12
@@ -36,3 +43,27 @@ func TestDay14Part1(t *testing.T) {
 		t.Errorf("Day 14 - Part 1 (safety factor) Test:\nwant %v\ngot %v\n", expectedSafetyFactor, safetyFactor)
 	}
 }
+
+func TestDay14Part2Timeout(t *testing.T) {
+	// the robots don't move, so they never end up in distinct locations
+	input := []string{
+		"p=1,1 v=0,0",
+		"p=1,1 v=0,0",
+	}
+
+	d14 := Day14{}
+
+	robots, err := d14.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err = d14.Part2(ctx, robots, 11, 7)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Day 14 - Part 2 (timeout) Test:\nwant %v\ngot %v\n", context.DeadlineExceeded, err)
+	}
+}
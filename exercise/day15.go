@@ -2,11 +2,13 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -16,44 +18,73 @@ type (
 		file string
 	}
 
-	BoxMap [][]rune
+	// BoxMap is the warehouse the robot moves the boxes around
+	BoxMap struct {
+		*grid.Grid[rune]
+	}
 
 	Instructions string
 )
 
+// init registers the Day 15 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 15, Title: "Warehouse Woes", File: "data/2024/day15/input.txt"}, func(name, file string) Exercise {
+		return &Day15{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 15 exercise
 func (d *Day15) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 15 exercise
+func (d *Day15) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 15 by retrieving the default file contents and uses that data
-func (d *Day15) Run(w io.Writer) {
+func (d *Day15) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 15 solution using the provided input data
-func (d *Day15) RunFromInput(w io.Writer, input []string) {
-	boxMap, instructions := d.parseInput(input)
+// RunFromInput executes the Day 15 solution using the provided input data and returns the answers
+func (d *Day15) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 15 solution using the provided input data and
+// returns the answer
+func (d *Day15) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	switch part {
+	case 1:
+		boxMap, instructions, err := d.parseInput(input)
+		if err != nil {
+			return Answer{Part: 1}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+		}
+
+		sumCoordinateValues := d.Part1(boxMap, instructions)
+		return Answer{Part: 1, Label: "The sum of the box coordinate values", Value: sumCoordinateValues}, nil
+	case 2:
+		boxMap, instructions, err := d.parseInputPart2(input)
+		if err != nil {
+			return Answer{Part: 2}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+		}
 
-	// part 1
-	sumCoordinateValues := d.Part1(boxMap, instructions)
-	w.Write([]byte(fmt.Sprintf("Day 15 - Part 1 - The sum of the box coordinate values is %d.\n", sumCoordinateValues)))
+		sumCoordinateValues := d.Part2(boxMap, instructions)
+		return Answer{Part: 2, Label: "The sum of the wide box coordinate values", Value: sumCoordinateValues}, nil
+	}
 
-	// part 2
-	boxMap, instructions = d.parseInputPart2(input)
-	sumCoordinateValues = d.Part2(boxMap, instructions)
-	w.Write([]byte(fmt.Sprintf("Day 15 - Part 2 - The sum of the box coordinate values is %d.\n", sumCoordinateValues)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 calculates the sum of the coordinate values per the instructions by
@@ -63,335 +94,142 @@ func (d *Day15) RunFromInput(w io.Writer, input []string) {
 // - boxes, specified by O, can be pushed into an open space
 // - instructions are < (left), ^ (up), > (right), and v (down)
 func (d *Day15) Part1(boxMap BoxMap, instructions Instructions) int {
-	posY, posX := boxMap.Find('@')
+	position, _ := boxMap.Find(grid.Equal('@'))
 
 	for _, instruction := range instructions {
-		posY, posX = boxMap.Move(instruction, posY, posX)
+		direction, _ := geometry.ParseDirection(instruction)
+		position = boxMap.Move(direction, position)
 	}
 
 	return boxMap.calculateSumCoordinateValues('O')
 }
 
-// Move takes the value at the specified position and moves it (if possible) according
-// to the specified instruction. Move returns the new y,x position after the move
-// occurs
-func (boxMap *BoxMap) Move(instruction rune, positionY, positionX int) (int, int) {
-	b := *boxMap
-
-	sizeY := len(b)
-	sizeX := len(b[0])
-
-	y, x := positionY, positionX
-	newPosY, newPosX := positionY, positionX
-
-	var move bool
-
-	switch instruction {
-	case '^':
-		for y = positionY - 1; y > 0; y-- {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
-
-			if b[y][x] == '#' {
-				break
-			}
+// Move takes the value at the specified position and moves it (if possible) in the
+// specified direction, pushing any boxes in the way. Move returns the new position after
+// the move occurs.
+func (boxMap *BoxMap) Move(direction geometry.Direction, position geometry.Point) geometry.Point {
+	b := boxMap.Grid
+
+	// look for an open spot past any boxes in the way
+	open := position.Move(direction)
+	for {
+		val, ok := b.Get(open.X, open.Y)
+		if !ok || val == '#' {
+			// there's no open spot, nothing moves
+			return position
 		}
 
-		if move {
-			// there's an open spot
-			if positionY-y > 1 {
-				// we hit boxes, push the box into the open spot
-				b[y][x] = b[positionY-1][positionX]
-			}
-
-			b[positionY-1][positionX] = b[positionY][positionX]
-			b[positionY][positionX] = '.'
-
-			newPosY = positionY - 1
-			newPosX = positionX
-		}
-	case '>':
-		for x = positionX + 1; x < sizeX-1; x++ {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
-
-			if b[y][x] == '#' {
-				break
-			}
+		if val == '.' {
+			break
 		}
 
-		if move {
-			// there's an open spot
-			if x-positionX > 1 {
-				// we hit boxes, push the box into the open spot
-				b[y][x] = b[positionY][positionX+1]
-			}
-
-			b[positionY][positionX+1] = b[positionY][positionX]
-			b[positionY][positionX] = '.'
-
-			newPosY = positionY
-			newPosX = positionX + 1
-		}
-	case 'v':
-		for y = positionY + 1; y < sizeY-1; y++ {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
-
-			if b[y][x] == '#' {
-				break
-			}
-		}
-
-		if move {
-			// there's an open spot
-			if y-positionY > 1 {
-				// we hit boxes, push the box into the open spot
-				b[y][x] = b[positionY+1][positionX]
-			}
-
-			b[positionY+1][positionX] = b[positionY][positionX]
-			b[positionY][positionX] = '.'
-
-			newPosY = positionY + 1
-			newPosX = positionX
-		}
-	case '<':
-		for x = positionX - 1; x > 0; x-- {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
-
-			if b[y][x] == '#' {
-				break
-			}
-		}
-
-		if move {
-			// there's an open spot
-			if positionX-x > 1 {
-				// we hit boxes, push the box into the open spot
-				b[y][x] = b[positionY][positionX-1]
-			}
-
-			b[positionY][positionX-1] = b[positionY][positionX]
-			b[positionY][positionX] = '.'
+		open = open.Move(direction)
+	}
 
-			newPosY = positionY
-			newPosX = positionX - 1
-		}
+	// shift everything between the position and the open spot into the open spot
+	for current := open; current != position; {
+		previous := current.Move(direction.Reverse())
+		b.Set(current.X, current.Y, b.At(previous.X, previous.Y))
+		current = previous
 	}
 
-	return newPosY, newPosX
+	b.Set(position.X, position.Y, '.')
+
+	return position.Move(direction)
 }
 
 // Part2
 func (d *Day15) Part2(boxMap BoxMap, instructions Instructions) int {
-	posY, posX := boxMap.Find('@')
+	position, _ := boxMap.Find(grid.Equal('@'))
 
 	for _, instruction := range instructions {
-		posY, posX = boxMap.MovePart2(instruction, posY, posX)
+		direction, _ := geometry.ParseDirection(instruction)
+		position = boxMap.MovePart2(direction, position)
 	}
 
 	return boxMap.calculateSumCoordinateValues('[')
 }
 
-// Move takes the value at the specified position and moves it (if possible) according
-// to the specified instruction. Move returns the new y,x position after the move
-// occurs
-func (boxMap *BoxMap) MovePart2(instruction rune, positionY, positionX int) (int, int) {
-	b := *boxMap
+// MovePart2 takes the value at the specified position and moves it (if possible) in the
+// specified direction on the expanded map, where a box ([]) is two positions wide and can
+// push the boxes above or below either of its halves. MovePart2 returns the new position
+// after the move occurs.
+func (boxMap *BoxMap) MovePart2(direction geometry.Direction, position geometry.Point) geometry.Point {
+	if direction == geometry.East || direction == geometry.West {
+		// a box only pushes the box beside it, as in part 1
+		return boxMap.Move(direction, position)
+	}
 
-	sizeY := len(b)
-	sizeX := len(b[0])
+	b := boxMap.Grid
+	dy := direction.Delta().Y
 
-	y, x := positionY, positionX
-	newPosY, newPosX := positionY, positionX
+	y, x := position.Y, position.X
 
 	var move bool
 
-	switch instruction {
-	case '^':
-		var xPositionsToCheck []int
-		xPositionsToCheck = append(xPositionsToCheck, x)
-		positionsToMove := make(map[int][]int)
-		positionsToMove[positionY] = xPositionsToCheck
-		for y = positionY - 1; y > 0; y-- {
-			isOpen := true
-			isBlocked := false
-			for _, xNew := range xPositionsToCheck {
-				if b[y][xNew] == '#' {
-					isOpen = false
-					isBlocked = true
-					break
-				}
-
-				if b[y][xNew] == ']' {
-					if !contains(xPositionsToCheck, xNew-1) {
-						xPositionsToCheck = append(xPositionsToCheck, xNew-1)
-					}
-					isOpen = false
-				}
-
-				if b[y][xNew] == '[' {
-					if !contains(xPositionsToCheck, xNew+1) {
-						xPositionsToCheck = append(xPositionsToCheck, xNew+1)
-					}
-					isOpen = false
-				}
-
-				if b[y][xNew] == '.' {
-					if contains(xPositionsToCheck, xNew) {
-						xPositionsToCheck = removeValue(xPositionsToCheck, xNew)
-					}
-				}
-			}
-
-			if isBlocked {
+	var xPositionsToCheck []int
+	xPositionsToCheck = append(xPositionsToCheck, x)
+	positionsToMove := make(map[int][]int)
+	positionsToMove[position.Y] = xPositionsToCheck
+	for y = position.Y + dy; y >= 0 && y < b.Height(); y += dy {
+		isOpen := true
+		isBlocked := false
+		for _, xNew := range xPositionsToCheck {
+			if b.At(xNew, y) == '#' {
+				isOpen = false
+				isBlocked = true
 				break
 			}
 
-			positionsToMove[y] = xPositionsToCheck
-
-			if isOpen {
-				move = true
-				break
-			}
-		}
-
-		if move {
-			// we hit boxes, push the box into the open spot
-			for newY := y; newY < positionY; newY++ {
-				xVals := positionsToMove[newY+1]
-				for _, newX := range xVals {
-					b[newY][newX] = b[newY+1][newX]
-					b[newY+1][newX] = '.'
+			if b.At(xNew, y) == ']' {
+				if !contains(xPositionsToCheck, xNew-1) {
+					xPositionsToCheck = append(xPositionsToCheck, xNew-1)
 				}
+				isOpen = false
 			}
 
-			b[positionY][positionX] = '.'
-
-			newPosY = positionY - 1
-			newPosX = positionX
-		}
-	case 'v':
-		var xPositionsToCheck []int
-		xPositionsToCheck = append(xPositionsToCheck, x)
-		positionsToMove := make(map[int][]int)
-		positionsToMove[positionY] = xPositionsToCheck
-		for y = positionY + 1; y < sizeY; y++ {
-			isOpen := true
-			isBlocked := false
-			for _, xNew := range xPositionsToCheck {
-				if b[y][xNew] == '#' {
-					isOpen = false
-					isBlocked = true
-					break
-				}
-
-				if b[y][xNew] == ']' {
-					if !contains(xPositionsToCheck, xNew-1) {
-						xPositionsToCheck = append(xPositionsToCheck, xNew-1)
-					}
-					isOpen = false
-				}
-
-				if b[y][xNew] == '[' {
-					if !contains(xPositionsToCheck, xNew+1) {
-						xPositionsToCheck = append(xPositionsToCheck, xNew+1)
-					}
-					isOpen = false
-				}
-
-				if b[y][xNew] == '.' {
-					if contains(xPositionsToCheck, xNew) {
-						xPositionsToCheck = removeValue(xPositionsToCheck, xNew)
-					}
+			if b.At(xNew, y) == '[' {
+				if !contains(xPositionsToCheck, xNew+1) {
+					xPositionsToCheck = append(xPositionsToCheck, xNew+1)
 				}
+				isOpen = false
 			}
 
-			if isBlocked {
-				break
-			}
-
-			positionsToMove[y] = xPositionsToCheck
-
-			if isOpen {
-				move = true
-				break
-			}
-		}
-
-		if move {
-			for newY := y; newY > positionY; newY-- {
-				xVals := positionsToMove[newY-1]
-				for _, newX := range xVals {
-					b[newY][newX] = b[newY-1][newX]
-					b[newY-1][newX] = '.'
+			if b.At(xNew, y) == '.' {
+				if contains(xPositionsToCheck, xNew) {
+					xPositionsToCheck = removeValue(xPositionsToCheck, xNew)
 				}
 			}
-
-			b[positionY][positionX] = '.'
-
-			newPosY = positionY + 1
-			newPosX = positionX
 		}
-	case '>':
-		for x = positionX + 1; x < sizeX; x++ {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
 
-			if b[y][x] == '#' {
-				break
-			}
+		if isBlocked {
+			break
 		}
 
-		if move {
-			// we hit boxes, push the box into the open spot
-			for newX := x; newX > positionX; newX-- {
-				b[y][newX] = b[y][newX-1]
-			}
-
-			b[positionY][positionX] = '.'
+		positionsToMove[y] = xPositionsToCheck
 
-			newPosY = positionY
-			newPosX = positionX + 1
+		if isOpen {
+			move = true
+			break
 		}
-	case '<':
-		for x = positionX - 1; x > 0; x-- {
-			if b[y][x] == '.' {
-				move = true
-				break
-			}
-
-			if b[y][x] == '#' {
-				break
-			}
-		}
-
-		if move {
-			// we hit boxes, push the box into the open spot
-			for newX := x; newX < positionX; newX++ {
-				b[y][newX] = b[y][newX+1]
-			}
+	}
 
-			b[positionY][positionX] = '.'
+	if !move {
+		return position
+	}
 
-			newPosY = positionY
-			newPosX = positionX - 1
+	// we hit boxes, push the boxes into the open spots
+	for newY := y; newY != position.Y; newY -= dy {
+		xVals := positionsToMove[newY-dy]
+		for _, newX := range xVals {
+			b.Set(newX, newY, b.At(newX, newY-dy))
+			b.Set(newX, newY-dy, '.')
 		}
 	}
 
-	return newPosY, newPosX
+	b.Set(position.X, position.Y, '.')
+
+	return position.Move(direction)
 }
 
 // contains determines whether the specified value is found in the specified []int
@@ -416,116 +254,95 @@ func removeValue(slice []int, value int) []int {
 	return result
 }
 
-// Find returns the position of the specified value (the first instance found)
-func (boxMap *BoxMap) Find(val rune) (int, int) {
-	b := *boxMap
-
-	sizeY := len(b)
-	if sizeY <= 0 {
-		return -1, -1
-	}
-
-	sizeX := len(b[0])
-	for y := 0; y < sizeY; y++ {
-		for x := 0; x < sizeX; x++ {
-			if b[y][x] == val {
-				return y, x
-			}
-		}
-	}
-
-	return -1, -1
-}
-
 // calculateSumCoordinateValues finds all instances of a O value and, using
 // the formula provided in the assignment, calculates the "coordinate value" of each.
 // A coordinate value is y * 100 + x (with y,x being the grid location -y row, x column)
 func (boxMap *BoxMap) calculateSumCoordinateValues(val rune) int {
-	b := *boxMap
-	sizeY := len(b)
-	if sizeY <= 0 {
-		return 0
-	}
-
-	sizeX := len(b[0])
-
 	sumCoordinateValues := 0
 
-	for y := 0; y < sizeY; y++ {
-		for x := 0; x < sizeX; x++ {
-			if b[y][x] == val {
-				sumCoordinateValues += 100*y + x
-			}
-		}
+	for _, position := range boxMap.FindAll(grid.Equal(val)) {
+		sumCoordinateValues += 100*position.Y + position.X
 	}
 
 	return sumCoordinateValues
 }
 
-// Print pretty-prints a BoxMap instance
-func (boxMap *BoxMap) Print() {
-	b := *boxMap
-	for y := 0; y < len(b); y++ {
-		fmt.Printf("%s\n", string(b[y]))
+// parseInput converts the input into a BoxMap and set of Instructions. An error is returned
+// if the input is malformed.
+func (d *Day15) parseInput(input []string) (BoxMap, Instructions, error) {
+	mapBlock, instructionsBlock, err := d.splitInput(input)
+	if err != nil {
+		return BoxMap{}, "", err
 	}
-}
 
-// parseInput converts the input into a BoxMap and set of Instructions
-func (d *Day15) parseInput(input []string) (BoxMap, Instructions) {
-	var boxMap BoxMap
-	var instructions string
+	boxMap, err := grid.Parse(mapBlock.Lines)
+	if err != nil {
+		return BoxMap{}, "", mapBlock.Err(err)
+	}
 
-	// Flag to identify whether we are processing the instructions part
-	isInstructions := false
+	// the instructions are a single sequence, ignoring newlines
+	return BoxMap{boxMap}, Instructions(strings.Join(instructionsBlock.Lines, "")), nil
+}
 
-	for _, line := range input {
-		// If an empty line is encountered, switch to instructions parsing
-		if line == "" {
-			isInstructions = true
-			continue
-		}
+// parseInputPart2 converts the input into a BoxMap (with the expanded map as specified
+// in the assignment) and set of Instructions. An error is returned if the input is malformed.
+func (d *Day15) parseInputPart2(input []string) (BoxMap, Instructions, error) {
+	mapBlock, instructionsBlock, err := d.splitInput(input)
+	if err != nil {
+		return BoxMap{}, "", err
+	}
 
-		if isInstructions {
-			// Append instructions, ignoring newlines
-			instructions += line
-		} else {
-			// Convert the line to a slice of runes and append to the BoxMap
-			boxMap = append(boxMap, []rune(line))
-		}
+	var expanded []string
+	for _, line := range mapBlock.Lines {
+		// convert the line according to the map rules
+		expanded = append(expanded, d.expandLine(line))
+	}
+
+	boxMap, err := grid.Parse(expanded)
+	if err != nil {
+		return BoxMap{}, "", mapBlock.Err(err)
 	}
 
-	return boxMap, Instructions(instructions)
+	// the instructions are a single sequence, ignoring newlines
+	return BoxMap{boxMap}, Instructions(strings.Join(instructionsBlock.Lines, "")), nil
 }
 
-// parseInput converts the input into a BoxMap (with the expanded map as specified
-// in the assignment) and set of Instructions
-func (d *Day15) parseInputPart2(input []string) (BoxMap, Instructions) {
-	var boxMap BoxMap
-	var instructions string
+// splitInput splits the input into the block of the map and the block of the robot's
+// instructions. An error is returned unless the input is a map containing a single robot
+// (@), followed by a blank line and the instructions (made up of ^, >, v, and <).
+func (d *Day15) splitInput(input []string) (fileprocessing.Block, fileprocessing.Block, error) {
+	sections, err := fileprocessing.Sections(input, 2)
+	if err != nil {
+		return fileprocessing.Block{}, fileprocessing.Block{}, fmt.Errorf("expected a blank line between the map and the instructions: %w", err)
+	}
 
-	// Flag to identify whether we are processing the instructions part
-	isInstructions := false
+	mapBlock, instructionsBlock := sections[0], sections[1]
+	if err := mapBlock.Err(fileprocessing.ValidateGrid(mapBlock.Lines)); err != nil {
+		return mapBlock, instructionsBlock, err
+	}
 
-	for _, line := range input {
-		// If an empty line is encountered, switch to instructions parsing
-		if line == "" {
-			isInstructions = true
-			continue
-		}
+	numRobots := 0
+	for _, line := range mapBlock.Lines {
+		numRobots += strings.Count(line, "@")
+	}
 
-		if isInstructions {
-			// Append instructions, ignoring newlines
-			instructions += line
-		} else {
-			// convert the line according to the map rules
-			line = d.expandLine(line)
+	if numRobots != 1 {
+		return mapBlock, instructionsBlock, fmt.Errorf("expected the map to contain 1 robot (@), found %d", numRobots)
+	}
 
-			// Convert the line to a slice of runes and append to the BoxMap
-			boxMap = append(boxMap, []rune(line))
+	for i, line := range instructionsBlock.Lines {
+		if index := strings.IndexFunc(line, isNotDirection); index >= 0 {
+			return mapBlock, instructionsBlock, instructionsBlock.LineErr(i, fmt.Errorf("invalid instruction %q", line[index]))
 		}
 	}
 
-	return boxMap, Instructions(instructions)
+	return mapBlock, instructionsBlock, nil
+}
+
+// isNotDirection returns true if r isn't one of the instructions (^, >, v, and <)
+func isNotDirection(r rune) bool {
+	_, ok := geometry.ParseDirection(r)
+	return !ok
 }
 
 // expandLine takes the specified string and, according to the rules of part 2,
@@ -20,7 +20,11 @@ func TestDay15Part1Smaller(t *testing.T) {
 
 	d15 := Day15{}
 
-	boxMap, instructions := d15.parseInput(input)
+	boxMap, instructions, err := d15.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	sumBoxCoordinates := d15.Part1(boxMap, instructions)
 	expectedSumBoxCoordinates := 2028
@@ -57,7 +61,11 @@ func TestDay15Part1(t *testing.T) {
 
 	d15 := Day15{}
 
-	boxMap, instructions := d15.parseInput(input)
+	boxMap, instructions, err := d15.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	sumBoxCoordinates := d15.Part1(boxMap, instructions)
 	expectedSumBoxCoordinates := 10092
@@ -82,7 +90,11 @@ func TestDay15Part2Smaller(t *testing.T) {
 
 	d15 := Day15{}
 
-	boxMap, instructions := d15.parseInputPart2(input)
+	boxMap, instructions, err := d15.parseInputPart2(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	sumBoxCoordinates := d15.Part2(boxMap, instructions)
 	expectedSumBoxCoordinates := 618
@@ -119,7 +131,11 @@ func TestDay15Part2(t *testing.T) {
 
 	d15 := Day15{}
 
-	boxMap, instructions := d15.parseInputPart2(input)
+	boxMap, instructions, err := d15.parseInputPart2(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	sumBoxCoordinates := d15.Part2(boxMap, instructions)
 	expectedSumBoxCoordinates := 9021
@@ -2,10 +2,11 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
 )
 
 type (
@@ -16,39 +17,60 @@ type (
 	}
 )
 
+// init registers the Day 16 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 16, Title: "Reindeer Maze", File: "data/2024/day16/input.txt"}, func(name, file string) Exercise {
+		return &Day16{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 16 exercise
 func (d *Day16) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 16 exercise
+func (d *Day16) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 16 by retrieving the default file contents and uses that data
-func (d *Day16) Run(w io.Writer) {
+func (d *Day16) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 16 solution using the provided input data
-func (d *Day16) RunFromInput(w io.Writer, input []string) {
-	maze := d.parseInput(input)
+// RunFromInput executes the Day 16 solution using the provided input data and returns the answers
+func (d *Day16) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	// part 1
-	cheapestPathCost := d.Part1(maze)
-	w.Write([]byte(fmt.Sprintf("Day 16 - Part 1 - The cheapest path through the maze costs %d.\n", cheapestPathCost)))
+// RunPart executes the specified part of the Day 16 solution using the provided input data and
+// returns the answer
+func (d *Day16) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	maze, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 1
-	maze = d.parseInput(input)
-	visitedNodes := d.Part2(maze)
-	w.Write([]byte(fmt.Sprintf("Day 16 - Part 2 - The number of nodes visited on the cheapest path(s) is %d.\n", visitedNodes)))
+	switch part {
+	case 1:
+		cheapestPathCost := d.Part1(maze)
+		return Answer{Part: 1, Label: "The cost of the cheapest path through the maze", Value: cheapestPathCost}, nil
+	case 2:
+		visitedNodes := d.Part2(maze)
+		return Answer{Part: 2, Label: "The number of nodes visited on the cheapest path(s)", Value: visitedNodes}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 traverses the maze and finds the shortest path cost from the start to
@@ -56,7 +78,7 @@ func (d *Day16) RunFromInput(w io.Writer, input []string) {
 func (d *Day16) Part1(maze Maze) int {
 	start := maze.findLocation('S')
 	end := maze.findLocation('E')
-	startDirection := east
+	startDirection := geometry.East
 
 	reindeerMazeGraph := buildMazeGraph(maze)
 
@@ -71,7 +93,7 @@ func (d *Day16) Part1(maze Maze) int {
 func (d *Day16) Part2(maze Maze) int {
 	start := maze.findLocation('S')
 	end := maze.findLocation('E')
-	startDirection := east
+	startDirection := geometry.East
 
 	reindeerMazeGraph := buildMazeGraph(maze)
 
@@ -92,23 +114,10 @@ func (d *Day16) Part2(maze Maze) int {
 	return len(visitedPositions)
 }
 
-// parseInput converts the input into a Maze
-func (d *Day16) parseInput(input []string) Maze {
-	maze := make([][]MazeLocation, len(input))
-
-	for i, line := range input {
-		row := make([]MazeLocation, len(line))
-
-		for j, char := range line {
-			row[j] = MazeLocation{
-				val: char,
-			}
-		}
-
-		maze[i] = row
-	}
-
-	return maze
+// parseInput converts the input into a Maze. An error is returned if the input isn't a
+// grid or is missing the start (S) or end (E) position.
+func (d *Day16) parseInput(input []string) (Maze, error) {
+	return parseMaze(input)
 }
 
 func calculateReindeerMazeCost(s *State, e *MazeEdge) int {
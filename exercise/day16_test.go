@@ -13,7 +13,11 @@ func TestDay16Part1Simple(t *testing.T) {
 
 	d16 := Day16{}
 
-	maze := d16.parseInput(input)
+	maze, err := d16.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	bestPath := d16.Part1(maze)
 	expectedBestPath := 12
@@ -44,7 +48,11 @@ func TestDay16Part1(t *testing.T) {
 
 	d16 := Day16{}
 
-	maze := d16.parseInput(input)
+	maze, err := d16.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	bestPath := d16.Part1(maze)
 	expectedBestPath := 7036
@@ -77,7 +85,11 @@ func TestDay16Part1Ex2(t *testing.T) {
 
 	d16 := Day16{}
 
-	maze := d16.parseInput(input)
+	maze, err := d16.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	bestPath := d16.Part1(maze)
 	expectedBestPath := 11048
@@ -108,7 +120,11 @@ func TestDay16Part2(t *testing.T) {
 
 	d16 := Day16{}
 
-	maze := d16.parseInput(input)
+	maze, err := d16.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	countVisitedNodes := d16.Part2(maze)
 	expectedcountVisitedNodes := 45
@@ -141,7 +157,11 @@ func TestDay16Part2Ex2(t *testing.T) {
 
 	d16 := Day16{}
 
-	maze := d16.parseInput(input)
+	maze, err := d16.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	countVisitedNodes := d16.Part2(maze)
 	expectedcountVisitedNodes := 64
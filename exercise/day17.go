@@ -2,11 +2,10 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"slices"
 	"strconv"
-	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -28,48 +27,83 @@ type (
 	}
 )
 
+// init registers the Day 17 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 17, Title: "Chronospatial Computer", File: "data/2024/day17/input.txt"}, func(name, file string) Exercise {
+		return &Day17{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 17 exercise
 func (d *Day17) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 17 exercise
+func (d *Day17) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 17 by retrieving the default file contents and uses that data
-func (d *Day17) Run(w io.Writer) {
+func (d *Day17) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 17 solution using the provided input data
-func (d *Day17) RunFromInput(w io.Writer, input []string) {
-	// part1
-	program := d.parseInput(input)
-	programOutput := d.Part1(program)
-	w.Write([]byte(fmt.Sprintf("Day 17 - Part 1 - The output of the program is: \n%s.\n", programOutput)))
+// RunFromInput executes the Day 17 solution using the provided input data and returns the answers
+func (d *Day17) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	program = d.parseInput(input)
-	lowestInitialA := d.Part2(program)
-	w.Write([]byte(fmt.Sprintf("Day 17 - Part 2 - The lowest positive value for A that causes the program to output a copy of itself is %d\n", lowestInitialA)))
+// RunPart executes the specified part of the Day 17 solution using the provided input data and
+// returns the answer
+func (d *Day17) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	program, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		programOutput, err := d.Part1(ctx, program)
+		if err != nil {
+			return Answer{Part: 1}, err
+		}
+
+		return Answer{Part: 1, Label: "The output of the program", Value: programOutput}, nil
+	case 2:
+		lowestInitialA, err := d.Part2(ctx, program)
+		if err != nil {
+			return Answer{Part: 2}, err
+		}
+
+		return Answer{Part: 2, Label: "The lowest positive value for A that causes the program to output a copy of itself", Value: lowestInitialA}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 runs the program specified by the input and returns the output
-func (d *Day17) Part1(program *DeviceProgram) string {
-	program.Run()
-	return program.output
+func (d *Day17) Part1(ctx context.Context, program *DeviceProgram) (string, error) {
+	if err := program.Run(ctx); err != nil {
+		return "", err
+	}
+
+	return program.output, nil
 }
 
 // Part2 iterates over the program in reverse, shifts the A input by 3 bits, and tries all
-// values for each location until a solution is found
-func (d *Day17) Part2(program *DeviceProgram) uint64 {
+// values for each location until a solution is found. An error is returned if the context is
+// done before a solution is found.
+func (d *Day17) Part2(ctx context.Context, program *DeviceProgram) (uint64, error) {
 	originalProgram := make([]int, len(program.program))
 	copy(originalProgram, program.program)
 
@@ -78,6 +112,10 @@ func (d *Day17) Part2(program *DeviceProgram) uint64 {
 	valuesToCheck := []uint64{0, 1, 2, 3, 4, 5, 6, 7}
 
 	for len(valuesToCheck) > 0 {
+		if err := ctx.Err(); err != nil {
+			return 0, err
+		}
+
 		// pull an A off the queue
 		originalA := valuesToCheck[0]
 		valuesToCheck = valuesToCheck[1:]
@@ -89,7 +127,10 @@ func (d *Day17) Part2(program *DeviceProgram) uint64 {
 			program.A = newA
 
 			// run the program with the value for A
-			program.Run()
+			if err := program.Run(ctx); err != nil {
+				return 0, err
+			}
+
 			// find the start index of the output (from the end) of the original program
 			startIndex := lenProgram - len(program.outputInt)
 
@@ -97,7 +138,7 @@ func (d *Day17) Part2(program *DeviceProgram) uint64 {
 				valuesToCheck = append(valuesToCheck, newA)
 				if len(program.outputInt) == lenProgram {
 					// the output matches the full program
-					return newA
+					return newA, nil
 				}
 			}
 
@@ -106,16 +147,24 @@ func (d *Day17) Part2(program *DeviceProgram) uint64 {
 		}
 	}
 
-	return 0
+	return 0, nil
 }
 
-// Run navigates through the instruction set of the specified DeviceProgram and returns the resulting output
-func (p *DeviceProgram) Run() {
+// Run navigates through the instruction set of the specified DeviceProgram and stores the
+// resulting output. A program that keeps jumping backwards never halts, so an error is
+// returned if the context is done before the program halts.
+func (p *DeviceProgram) Run(ctx context.Context) error {
 	nextOperation := 0
-	for {
+	for steps := 1; ; steps++ {
 		nextOperation = p.DoInstruction(nextOperation)
 		if nextOperation >= len(p.program)-1 {
-			break
+			return nil
+		}
+
+		if steps%contextCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
 		}
 	}
 }
@@ -197,34 +246,47 @@ func (p *DeviceProgram) getComboOperandValue(operand int) uint64 {
 	return comboOperand
 }
 
-// parseInput parses the specified input data and returns a corresponding DeviceProgram instance
-func (d *Day17) parseInput(input []string) *DeviceProgram {
+// parseInput parses the specified input data and returns a corresponding DeviceProgram instance.
+// An error is returned if a register or the program can't be parsed.
+func (d *Day17) parseInput(input []string) (*DeviceProgram, error) {
 	var dp DeviceProgram
 
-	// Parse register lines
-	for _, line := range input {
-		if strings.HasPrefix(line, "Register A:") {
-			dp.A = parseRegisterValue(line)
-		} else if strings.HasPrefix(line, "Register B:") {
-			dp.B = parseRegisterValue(line)
-		} else if strings.HasPrefix(line, "Register C:") {
-			dp.C = parseRegisterValue(line)
-		} else if strings.HasPrefix(line, "Program:") {
-			parts := strings.Split(line, ":")
-			valuesStr := strings.Split(strings.TrimSpace(parts[1]), ",")
-			for _, v := range valuesStr {
-				num, _ := strconv.Atoi(strings.TrimSpace(v))
-				dp.program = append(dp.program, num)
+	values, err := fileprocessing.ParseKeyValues(input)
+	if err != nil {
+		return nil, err
+	}
+
+	hasProgram := false
+
+	for _, kv := range values {
+		switch kv.Key {
+		case "Register A":
+			dp.A, err = strconv.ParseUint(kv.Value, 10, 64)
+		case "Register B":
+			dp.B, err = strconv.ParseUint(kv.Value, 10, 64)
+		case "Register C":
+			dp.C, err = strconv.ParseUint(kv.Value, 10, 64)
+		case "Program":
+			hasProgram = true
+			dp.program, err = fileprocessing.ExtractInts(kv.Value)
+			for _, num := range dp.program {
+				if num < 0 || num > 7 {
+					err = fmt.Errorf("%d is not a 3-bit value", num)
+					break
+				}
 			}
+		default:
+			err = fmt.Errorf("unrecognized line")
+		}
+
+		if err != nil {
+			return nil, kv.Err(err)
 		}
 	}
 
-	return &dp
-}
+	if !hasProgram {
+		return nil, fmt.Errorf("the input is missing the program")
+	}
 
-// parseRegisterValue extracts an integer value from a register line
-func parseRegisterValue(line string) uint64 {
-	parts := strings.Split(line, ":")
-	value, _ := strconv.Atoi(strings.TrimSpace(parts[1]))
-	return uint64(value)
+	return &dp, nil
 }
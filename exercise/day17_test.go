@@ -1,7 +1,10 @@
 package exercise
 
 import (
+	"context"
+	"errors"
 	"testing"
+	"time"
 )
 
 func TestDay17Part1Sample1(t *testing.T) {
@@ -15,9 +18,16 @@ func TestDay17Part1Sample1(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	_ = d17.Part1(program)
+	if _, err := d17.Part1(context.Background(), program); err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := uint64(1)
 
 	if program.B != expectedOutput {
@@ -36,9 +46,17 @@ func TestDay17Part1Sample2(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "0,1,2"
 
 	if output != expectedOutput {
@@ -57,9 +75,17 @@ func TestDay17Part1Sample3(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "4,2,5,6,7,7,7,7,3,1,0"
 
 	if output != expectedOutput {
@@ -82,9 +108,16 @@ func TestDay17Part1Sample4(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	_ = d17.Part1(program)
+	if _, err := d17.Part1(context.Background(), program); err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := uint64(26)
 
 	if program.B != expectedOutput {
@@ -103,9 +136,16 @@ func TestDay17Part1Sample5(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	_ = d17.Part1(program)
+	if _, err := d17.Part1(context.Background(), program); err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := uint64(44354)
 
 	if program.B != expectedOutput {
@@ -124,9 +164,17 @@ func TestDay17Part1(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "4,6,3,5,6,3,5,2,1,0"
 
 	if output != expectedOutput {
@@ -145,9 +193,17 @@ func TestDay17Part1Input(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "6,2,7,2,3,1,6,0,5"
 
 	if output != expectedOutput {
@@ -166,9 +222,17 @@ func TestDay17Part2TestConfirm(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "0,3,5,4,3,0"
 
 	if output != expectedOutput {
@@ -187,9 +251,17 @@ func TestDay17Part2(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part2(program)
+	output, err := d17.Part2(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := uint64(117440)
 
 	if output != expectedOutput {
@@ -208,12 +280,47 @@ func TestDay17Part2Input(t *testing.T) {
 
 	d17 := Day17{}
 
-	program := d17.parseInput(input)
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
-	output := d17.Part1(program)
+	output, err := d17.Part1(context.Background(), program)
+	if err != nil {
+		t.Errorf("There was an error running the program: %v", err)
+		return
+	}
 	expectedOutput := "2,4,1,3,7,5,1,5,0,3,4,3,5,5,3,0"
 
 	if output != expectedOutput {
 		t.Errorf("Day 17 - Part 2 (find A where the output matches -input) Test:\nwant %v\ngot %v\n", expectedOutput, output)
 	}
 }
+
+func TestDay17RunTimeout(t *testing.T) {
+	// 3,0 jumps back to the start for as long as A isn't 0, so the program never halts
+	input := []string{
+		"Register A: 1",
+		"Register B: 0",
+		"Register C: 0",
+		"",
+		"Program: 3,0",
+	}
+
+	d17 := Day17{}
+
+	program, err := d17.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err = program.Run(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Day 17 - Run (timeout) Test:\nwant %v\ngot %v\n", context.DeadlineExceeded, err)
+	}
+}
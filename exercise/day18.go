@@ -2,13 +2,13 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
 	"strconv"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
 )
 
 type (
@@ -18,111 +18,138 @@ type (
 		file string
 	}
 
-	FallingBlocks []MazePoint
+	FallingBlocks []geometry.Point
 )
 
+// init registers the Day 18 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 18, Title: "RAM Run", File: "data/2024/day18/input.txt"}, func(name, file string) Exercise {
+		return &Day18{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 18 exercise
 func (d *Day18) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 18 exercise
+func (d *Day18) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 18 by retrieving the default file contents and uses that data
-func (d *Day18) Run(w io.Writer) {
+func (d *Day18) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day 18 solution using the provided input data and returns the answers
+func (d *Day18) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// RunFromInput executs the Day 18 solution using the provided input data
-func (d *Day18) RunFromInput(w io.Writer, input []string) {
+// RunPart executes the specified part of the Day 18 solution using the provided input data and
+// returns the answer
+func (d *Day18) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	startStep := 1024
 	gridSize := 71
-	fallingBlocks := d.parseInput(input)
 
-	// part 1
-	steps := d.Part1(fallingBlocks, gridSize, startStep)
-	w.Write([]byte(fmt.Sprintf("Day 18 - Part 1 - The shortest path distance is: %d\n", steps)))
+	fallingBlocks, err := d.parseInput(input, gridSize)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	if startStep >= len(fallingBlocks) {
+		return Answer{Part: part}, fmt.Errorf("the input has %d blocks, at least %d are needed", len(fallingBlocks), startStep+1)
+	}
+
+	switch part {
+	case 1:
+		steps := d.Part1(fallingBlocks, gridSize, startStep)
+		return Answer{Part: 1, Label: "The shortest path distance", Value: steps}, nil
+	case 2:
+		y, x, err := d.Part2(ctx, fallingBlocks, gridSize, startStep)
+		if err != nil {
+			return Answer{Part: 2}, err
+		}
+
+		return Answer{Part: 2, Label: "The coordinates of the block that breaks the map", Value: fmt.Sprintf("%d,%d", y, x)}, nil
+	}
 
-	y, x := d.Part2(fallingBlocks, gridSize, startStep)
-	w.Write([]byte(fmt.Sprintf("Day 18 - Part 2 - The coordinates of the block that breaks the map is: %d,%d\n", y, x)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
-// Part1
+// Part1 drops the first startStep blocks into the memory space and returns the length of
+// the shortest path from the top-left to the bottom-right corner. -1 is returned if there
+// aren't more than startStep blocks.
 func (d *Day18) Part1(fallingBlocks FallingBlocks, gridSize int, startStep int) int {
 	if startStep >= len(fallingBlocks) {
-		log.Fatalf("invalid input: startStep is invalid")
+		return -1
 	}
 
 	// build the grid
-	memoryMaze := Maze(make([][]MazeLocation, gridSize))
-	for i := 0; i < gridSize; i++ {
-		memoryMaze[i] = make([]MazeLocation, gridSize)
-		for j := 0; j < gridSize; j++ {
-			// set default value for each MazeLocation
-			memoryMaze[i][j] = MazeLocation{val: '.'} // '.' represents an empty cell
-		}
-	}
+	memoryMaze := newMaze(gridSize, gridSize)
 
 	for i := 0; i < startStep; i++ {
 		// add the falling blocks to the grid at each fallingBlocks location
 		blockLocation := fallingBlocks[i]
-		memoryMaze[blockLocation.Y][blockLocation.X].val = '#'
+		memoryMaze.Set(blockLocation.X, blockLocation.Y, '#')
 	}
 
 	memoryMazeGraph := buildMazeGraph(memoryMaze)
 
-	start := MazePoint{Y: 0, X: 0}
-	end := MazePoint{Y: gridSize - 1, X: gridSize - 1}
+	start := MazePoint{Point: geometry.Point{X: 0, Y: 0}}
+	end := MazePoint{Point: geometry.Point{X: gridSize - 1, Y: gridSize - 1}}
 
-	cheapestPath := findLowestCostMazePath(memoryMazeGraph, start, end, south, calculateMemoryMazeCost)
+	cheapestPath := findLowestCostMazePath(memoryMazeGraph, start, end, geometry.South, calculateMemoryMazeCost)
 
 	return cheapestPath
 }
 
-// Part2
-func (d *Day18) Part2(fallingBlocks FallingBlocks, gridSize int, startStep int) (y int, x int) {
+// Part2 keeps dropping blocks after startStep until the path from the top-left to the
+// bottom-right corner is blocked and returns the coordinates of that block. -1, -1 is
+// returned if there aren't more than startStep blocks. Finding a path after each block is
+// slow, so an error is returned if the context is done before the block is found.
+func (d *Day18) Part2(ctx context.Context, fallingBlocks FallingBlocks, gridSize int, startStep int) (y int, x int, err error) {
 	if startStep >= len(fallingBlocks) {
-		log.Fatalf("invalid input: startStep is invalid")
+		return -1, -1, nil
 	}
 
 	// build the grid
-	memoryMaze := Maze(make([][]MazeLocation, gridSize))
-	for i := 0; i < gridSize; i++ {
-		memoryMaze[i] = make([]MazeLocation, gridSize)
-		for j := 0; j < gridSize; j++ {
-			// set default value for each MazeLocation
-			memoryMaze[i][j] = MazeLocation{val: '.'} // '.' represents an empty cell
-		}
-	}
+	memoryMaze := newMaze(gridSize, gridSize)
 
 	for i := 0; i < startStep; i++ {
 		// add the falling blocks to the grid at each fallingBlocks location
 		blockLocation := fallingBlocks[i]
-		memoryMaze[blockLocation.Y][blockLocation.X].val = '#'
+		memoryMaze.Set(blockLocation.X, blockLocation.Y, '#')
 	}
 
-	start := MazePoint{Y: 0, X: 0}
-	end := MazePoint{Y: gridSize - 1, X: gridSize - 1}
+	start := MazePoint{Point: geometry.Point{X: 0, Y: 0}}
+	end := MazePoint{Point: geometry.Point{X: gridSize - 1, Y: gridSize - 1}}
 
 	remainingBlocks := fallingBlocks[startStep:]
 
 	for i := 0; i < len(remainingBlocks); i++ {
+		if err := ctx.Err(); err != nil {
+			return -1, -1, err
+		}
+
 		// set the maze location
 		block := remainingBlocks[i]
-		memoryMaze[block.Y][block.X].val = '#'
+		memoryMaze.Set(block.X, block.Y, '#')
 
 		// rebuild the graph and find a path
 		memoryMazeGraph := buildMazeGraph(memoryMaze)
-		if -1 == findLowestCostMazePath(memoryMazeGraph, start, end, south, calculateMemoryMazeCost) {
+		if -1 == findLowestCostMazePath(memoryMazeGraph, start, end, geometry.South, calculateMemoryMazeCost) {
 			// not path found - we have the block
 			y = block.Y
 			x = block.X
@@ -130,32 +157,40 @@ func (d *Day18) Part2(fallingBlocks FallingBlocks, gridSize int, startStep int)
 		}
 	}
 
-	return y, x
+	return y, x, nil
 }
 
-// parseInput takes the specified input and converts it into a FallingBlocks structure
-func (d *Day18) parseInput(input []string) FallingBlocks {
+// parseInput takes the specified input and converts it into a FallingBlocks structure. An
+// error is returned if a line isn't a pair of coordinates inside a grid of gridSize.
+func (d *Day18) parseInput(input []string, gridSize int) (FallingBlocks, error) {
 	var fallingBlocks FallingBlocks
 
-	for _, line := range input {
+	for i, line := range input {
 		coords := strings.Split(line, ",")
 		if len(coords) != 2 {
-			log.Fatalf("the input is invalid")
+			return nil, fileprocessing.NewParseError(i+1, line, fmt.Errorf("expected a pair of coordinates"))
 		}
 
 		// convert the Y and X values
-		y, err1 := strconv.Atoi(coords[0])
-		x, err2 := strconv.Atoi(coords[1])
+		y, err := strconv.Atoi(coords[0])
+		if err != nil {
+			return nil, fileprocessing.NewParseError(i+1, line, err)
+		}
+
+		x, err := strconv.Atoi(coords[1])
+		if err != nil {
+			return nil, fileprocessing.NewParseError(i+1, line, err)
+		}
 
-		if err1 != nil || err2 != nil {
-			log.Fatalf("the input is invalid")
+		if y < 0 || y >= gridSize || x < 0 || x >= gridSize {
+			return nil, fileprocessing.NewParseError(i+1, line, fmt.Errorf("the coordinates are outside of the %d by %d grid", gridSize, gridSize))
 		}
 
-		// add the MazePoint to the FallingBlocks slice
-		fallingBlocks = append(fallingBlocks, MazePoint{Y: y, X: x})
+		// add the position to the FallingBlocks slice
+		fallingBlocks = append(fallingBlocks, geometry.Point{X: x, Y: y})
 	}
 
-	return fallingBlocks
+	return fallingBlocks, nil
 }
 
 // calculateMemoryMazeCost determines the cost of a particular state in a Maze instance
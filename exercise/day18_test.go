@@ -1,6 +1,7 @@
 package exercise
 
 import (
+	"context"
 	"testing"
 )
 
@@ -35,10 +36,15 @@ func TestDay18Part1(t *testing.T) {
 
 	d18 := Day18{}
 
-	fallingBlocks := d18.parseInput(input)
-
 	gridSize := 7
 	startTick := 12
+
+	fallingBlocks, err := d18.parseInput(input, gridSize)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
 	steps := d18.Part1(fallingBlocks, gridSize, startTick)
 	expectedSteps := 22
 
@@ -78,11 +84,20 @@ func TestDay18Part2(t *testing.T) {
 
 	d18 := Day18{}
 
-	fallingBlocks := d18.parseInput(input)
-
 	gridSize := 7
 	startTick := 12
-	y, x := d18.Part2(fallingBlocks, gridSize, startTick)
+
+	fallingBlocks, err := d18.parseInput(input, gridSize)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
+	y, x, err := d18.Part2(context.Background(), fallingBlocks, gridSize, startTick)
+	if err != nil {
+		t.Errorf("There was an error finding the block: %v", err)
+		return
+	}
 	expectedY, expectedX := 6, 1
 
 	if y != expectedY || x != expectedX {
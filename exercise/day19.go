@@ -2,8 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
@@ -20,36 +20,60 @@ type (
 	TowelDesigns []string
 )
 
+// init registers the Day 19 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 19, Title: "Linen Layout", File: "data/2024/day19/input.txt"}, func(name, file string) Exercise {
+		return &Day19{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 19 exercise
 func (d *Day19) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 19 exercise
+func (d *Day19) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 19 by retrieving the default file contents and uses that data
-func (d *Day19) Run(w io.Writer) {
+func (d *Day19) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 19 solution using the provided input data
-func (d *Day19) RunFromInput(w io.Writer, input []string) {
-	towels, towelDesigns := d.parseInput(input)
+// RunFromInput executes the Day 19 solution using the provided input data and returns the answers
+func (d *Day19) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	possibleTowelDesigns := d.Part1(towels, towelDesigns)
-	w.Write([]byte(fmt.Sprintf("Day 19 - Part 1 - The number of possible towel designs: %d\n", possibleTowelDesigns)))
+// RunPart executes the specified part of the Day 19 solution using the provided input data and
+// returns the answer
+func (d *Day19) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	towels, towelDesigns, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	sumTowelCombinationsThatSolve := d.Part2(towels, towelDesigns)
-	w.Write([]byte(fmt.Sprintf("Day 19 - Part 2 - The sum of the towel combinations tha that solve the designs is: %d\n", sumTowelCombinationsThatSolve)))
+	switch part {
+	case 1:
+		possibleTowelDesigns := d.Part1(towels, towelDesigns)
+		return Answer{Part: 1, Label: "The number of possible towel designs", Value: possibleTowelDesigns}, nil
+	case 2:
+		sumTowelCombinationsThatSolve := d.Part2(towels, towelDesigns)
+		return Answer{Part: 2, Label: "The sum of the towel combinations that solve the designs", Value: sumTowelCombinationsThatSolve}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 iterates through the various designs and determines if the specified
@@ -130,22 +154,30 @@ func (d *Day19) countWaysToBuildDesign(towelDesign string, towels []string) int
 }
 
 // parseInput takes the assignment's specified input and parses it into Towels and
-// TowelDesigns structures
-func (d *Day19) parseInput(input []string) (Towels, TowelDesigns) {
-	if len(input) == 0 {
-		return nil, nil
+// TowelDesigns structures. An error is returned if the towels aren't followed by a blank
+// line and the designs.
+func (d *Day19) parseInput(input []string) (Towels, TowelDesigns, error) {
+	sections, err := fileprocessing.Sections(input, 2)
+	if err != nil {
+		return nil, nil, fmt.Errorf("expected a line of towels, a blank line, and the designs: %w", err)
+	}
+
+	if len(sections[0].Lines) != 1 {
+		return nil, nil, sections[0].LineErr(1, fmt.Errorf("expected a blank line after the towels"))
 	}
 
 	// first line contains the towels, separated by commas.
-	towelsLine := input[0]
+	towelsLine := sections[0].Lines[0]
 	towels := strings.Split(towelsLine, ",")
 	for i, t := range towels {
 		towels[i] = strings.TrimSpace(t)
+		if towels[i] == "" {
+			return nil, nil, sections[0].LineErr(0, fmt.Errorf("towel %d is empty", i+1))
+		}
 	}
 
-	// subsequent lines (skipping the blank line that separates towels from the various
-	// designs) are towel designs
-	towelDesigns := input[2:]
+	// the lines that follow the blank line are towel designs
+	towelDesigns := sections[1].Lines
 
-	return Towels(towels), TowelDesigns(towelDesigns)
+	return Towels(towels), TowelDesigns(towelDesigns), nil
 }
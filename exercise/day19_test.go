@@ -20,7 +20,11 @@ func TestDay19Part1(t *testing.T) {
 
 	d19 := Day19{}
 
-	towels, desiredDesigns := d19.parseInput(input)
+	towels, desiredDesigns, err := d19.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	possibleDesigns := d19.Part1(towels, desiredDesigns)
 	expectedPossibleDesigns := 6
@@ -46,7 +50,11 @@ func TestDay19Part2(t *testing.T) {
 
 	d19 := Day19{}
 
-	towels, desiredDesigns := d19.parseInput(input)
+	towels, desiredDesigns, err := d19.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	sumDesignSolutions := d19.Part2(towels, desiredDesigns)
 	expectedSumDesignSolutions := 16
@@ -1,7 +1,12 @@
 package exercise
 
 import (
+	"context"
+	"errors"
+	"strings"
 	"testing"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
 )
 
 func TestDay1SumSmallestDistances(t *testing.T) {
@@ -53,3 +58,85 @@ func TestDay1SumSimilarityScores(t *testing.T) {
 		t.Errorf("Day One - Part 2 Test:\nwant %v\ngot %v\n", expectedValue, calculatedValue)
 	}
 }
+
+func TestDay1RunFromInput(t *testing.T) {
+	input := []string{
+		"3   4",
+		"4   3",
+		"2   5",
+		"1   3",
+		"3   9",
+		"3   3",
+	}
+
+	d1 := Day1{name: "2024: Day 1"}
+	result, err := d1.RunFromInput(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Day One - RunFromInput Test: unexpected error %v", err)
+	}
+
+	expectedAnswers := []string{"11", "31"}
+	if len(result.Answers) != len(expectedAnswers) {
+		t.Fatalf("Day One - RunFromInput Test:\nwant %d answers\ngot %d\n", len(expectedAnswers), len(result.Answers))
+	}
+
+	for i, answer := range result.Answers {
+		if answer.Part != i+1 || answer.String() != expectedAnswers[i] {
+			t.Errorf("Day One - RunFromInput Test (part %d):\nwant %v\ngot %v\n", i+1, expectedAnswers[i], answer)
+		}
+	}
+}
+
+func TestDay1RunPart(t *testing.T) {
+	input := []string{
+		"3   4",
+		"4   3",
+		"2   5",
+		"1   3",
+		"3   9",
+		"3   3",
+	}
+
+	d1 := Day1{name: "2024: Day 1"}
+	result, err := RunParts(context.Background(), &d1, input, 2)
+	if err != nil {
+		t.Fatalf("Day One - RunPart Test: unexpected error %v", err)
+	}
+
+	if len(result.Answers) != 1 || result.Answers[0].Part != 2 || result.Answers[0].String() != "31" {
+		t.Errorf("Day One - RunPart Test:\nwant %v\ngot %v\n", "31", result.Answers)
+	}
+
+	_, err = d1.RunPart(context.Background(), input, 3)
+	if !errors.Is(err, ErrInvalidPart) {
+		t.Errorf("Day One - RunPart Test (part 3):\nwant %v\ngot %v\n", ErrInvalidPart, err)
+	}
+}
+
+func TestDay1RunFromReader(t *testing.T) {
+	input, err := fileprocessing.Read(strings.NewReader("3   4\n4   3\n2   5\n1   3\n3   9\n3   3\n"))
+	if err != nil {
+		t.Fatalf("Day One - Reader Test: unexpected error %v", err)
+	}
+
+	d1 := Day1{name: "2024: Day 1"}
+	result, err := d1.RunFromInput(context.Background(), input)
+	if err != nil || len(result.Answers) != 2 || result.Answers[0].String() != "11" || result.Answers[1].String() != "31" {
+		t.Errorf("Day One - Reader Test:\nwant %v\ngot %v (%v)\n", []string{"11", "31"}, result.Answers, err)
+	}
+}
+
+func TestDay1ParseError(t *testing.T) {
+	d1 := Day1{}
+
+	for _, line := range []string{"3 x 4", "3abc 4", "3-4"} {
+		input := []string{"3   4", line, "2   5"}
+
+		_, err := d1.RunFromInput(context.Background(), input)
+
+		var parseErr *fileprocessing.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+			t.Errorf("Day 1 - Parse Error Test: %q\nwant an error on line %v\ngot %v\n", line, 2, err)
+		}
+	}
+}
@@ -2,10 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"strconv"
-	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -21,44 +19,60 @@ type (
 	Report []Level
 )
 
+// init registers the Day 2 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 2, Title: "Red-Nosed Reports", File: "data/2024/day2/input.txt"}, func(name, file string) Exercise {
+		return &Day2{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 2 exercise
 func (d *Day2) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 2 exercise
+func (d *Day2) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 2 by retrieving the default file contents and uses that data
-func (d *Day2) Run(w io.Writer) {
+func (d *Day2) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 2 solution using the provided input data
-func (d *Day2) RunFromInput(w io.Writer, input []string) {
+// RunFromInput executes the Day 2 solution using the provided input data and returns the answers
+func (d *Day2) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 2 solution using the provided input data and
+// returns the answer
+func (d *Day2) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	reports, err := d.parseIntoReports(input)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	numSafeReports := d.Part1(reports)
-
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 2 - Part 1 - The sum of safe reports is %d.\n", numSafeReports)))
-
-	numSafeReports = d.Part2(reports)
+	switch part {
+	case 1:
+		numSafeReports := d.Part1(reports)
+		return Answer{Part: 1, Label: "The sum of safe reports", Value: numSafeReports}, nil
+	case 2:
+		numSafeReports := d.Part2(reports)
+		return Answer{Part: 2, Label: "The sum of safe reports", Value: numSafeReports}, nil
+	}
 
-	// part 2
-	w.Write([]byte(fmt.Sprintf("Day 2 - Part 2 - The sum of safe reports is %d.\n", numSafeReports)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 counts which Report entries are "safe"
@@ -130,23 +144,18 @@ func (d *Day2) Part2(reports []Report) int {
 // parseIntoReports takes the input string array and converts it into an array of Report
 // structures (which is an array of Level structures, each of which is just an int)
 func (d *Day2) parseIntoReports(input []string) ([]Report, error) {
-	var result []Report
-
-	for _, line := range input {
-		// Split the line into fields by spaces
-		parts := strings.Fields(line)
-		var report Report
-
-		for _, part := range parts {
-			// Convert each part to an integer
-			num, err := strconv.Atoi(part)
-			if err != nil {
-				return nil, fmt.Errorf("error parsing '%s': %w", part, err)
-			}
+	rows, err := fileprocessing.ParseInts(input, "", 0)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Report, 0, len(rows))
+	for _, row := range rows {
+		report := make(Report, 0, len(row))
+		for _, num := range row {
 			report = append(report, Level(num))
 		}
 
-		// Append the row of integers to the result
 		result = append(result, report)
 	}
 
@@ -2,10 +2,11 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
 )
 
 type (
@@ -16,46 +17,60 @@ type (
 	}
 )
 
+// init registers the Day 20 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 20, Title: "Race Condition", File: "data/2024/day20/input.txt"}, func(name, file string) Exercise {
+		return &Day20{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 20 exercise
 func (d *Day20) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 20 exercise
+func (d *Day20) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 20 by retrieving the default file contents and uses that data
-func (d *Day20) Run(w io.Writer) {
+func (d *Day20) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 20 solution using the provided input data
-func (d *Day20) RunFromInput(w io.Writer, input []string) {
-	raceTrack := d.parseInput(input)
+// RunFromInput executes the Day 20 solution using the provided input data and returns the answers
+func (d *Day20) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	// part 1
-	numberOfCheatsThatSave100 := d.Part1(raceTrack, 100)
-	w.Write([]byte(fmt.Sprintf("Day 20 - Part 1 - The number of cheats that save 100 is %d.\n", numberOfCheatsThatSave100)))
+// RunPart executes the specified part of the Day 20 solution using the provided input data and
+// returns the answer
+func (d *Day20) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	raceTrack, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	// part 2
-	raceTrack = d.parseInput(input)
-	numberOfCheatsThatSave100 = d.Part2(raceTrack, 100)
-	w.Write([]byte(fmt.Sprintf("Day 20 - Part 2 - The number of cheats that save 100 is %d.\n", numberOfCheatsThatSave100)))
-}
+	switch part {
+	case 1:
+		numberOfCheatsThatSave100 := d.Part1(raceTrack, 100)
+		return Answer{Part: 1, Label: "The number of cheats that save 100", Value: numberOfCheatsThatSave100}, nil
+	case 2:
+		numberOfCheatsThatSave100 := d.Part2(raceTrack, 100)
+		return Answer{Part: 2, Label: "The number of 20 picosecond cheats that save 100", Value: numberOfCheatsThatSave100}, nil
+	}
 
-// RaceTrackPosition is a Y,X coordinate for a position on the Maze (grid). Maze uses
-// MazePoint, which has a pointCost field, so I didn't want to use that as my map key
-// where I need to use a map for each Maze position. And so, RaceTrackPosition was created.
-type RaceTrackPosition struct {
-	Y, X int
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 determines the single path of the race track provided that works, then goes
@@ -67,30 +82,27 @@ func (d *Day20) Part1(raceTrack Maze, threshold int) int {
 
 	path := d.GetMazePath(raceTrack, start, end)
 	// dictionary of positions for quick lookups
-	positionDict := make(map[RaceTrackPosition]int)
+	// (keyed by the position alone, since a MazePoint includes its pointCost)
+	positionDict := make(map[geometry.Point]int)
 
 	originalPathLength := len(path)
 	for i := 0; i < originalPathLength; i++ {
-		position := RaceTrackPosition{Y: path[i].Y, X: path[i].X}
-		positionDict[position] = path[i].pointCost
+		positionDict[path[i].Point] = path[i].pointCost
 	}
 
 	// cheats is a map of positions where the specified key is the distance saved
-	cheats := make(map[int][]RaceTrackPosition)
+	cheats := make(map[int][]geometry.Point)
 
 	for i := 0; i < originalPathLength; i++ {
-		for _, direction := range directionDeltas {
+		for _, direction := range geometry.Directions {
 			// position where we should look for a wall
-			wallPositionY := path[i].Y + direction.dy
-			wallPositionX := path[i].X + direction.dx
+			wallPosition := path[i].Move(direction)
 			// position where we should look for a continuation of the path
-			pathCheckY := path[i].Y + (direction.dy * 2)
-			pathCheckX := path[i].X + (direction.dx * 2)
+			positionToCheck := path[i].Add(direction.Delta().Scale(2))
 
-			if raceTrack[wallPositionY][wallPositionX].val == '#' {
+			if raceTrack.At(wallPosition.X, wallPosition.Y) == '#' {
 				// there is an adjacent wall
 
-				positionToCheck := RaceTrackPosition{Y: pathCheckY, X: pathCheckX}
 				if positionDict[positionToCheck] > 0 {
 					// new cheat found, calculate the distance
 					distanceBetween := positionDict[positionToCheck] - path[i].pointCost
@@ -126,12 +138,12 @@ func (d *Day20) Part2(raceTrack Maze, threshold int) int {
 
 	path := d.GetMazePath(raceTrack, start, end)
 	// dictionary of positions for quick lookups
-	positionDict := make(map[RaceTrackPosition]int)
+	// (keyed by the position alone, since a MazePoint includes its pointCost)
+	positionDict := make(map[geometry.Point]int)
 
 	originalPathLength := len(path)
 	for i := 0; i < originalPathLength; i++ {
-		position := RaceTrackPosition{Y: path[i].Y, X: path[i].X}
-		positionDict[position] = path[i].pointCost
+		positionDict[path[i].Point] = path[i].pointCost
 	}
 
 	// cheats is a map of positions where the specified key is the distance saved
@@ -140,7 +152,7 @@ func (d *Day20) Part2(raceTrack Maze, threshold int) int {
 	for i := 0; i < originalPathLength; i++ {
 		for j := i + 1; j < originalPathLength; j++ {
 			pathDistance := j - i
-			manhattanDistance := absInt(path[j].Y-path[i].Y) + absInt(path[j].X-path[i].X)
+			manhattanDistance := path[j].Manhattan(path[i].Point)
 
 			if manhattanDistance <= 20 {
 				saved := pathDistance - manhattanDistance
@@ -170,30 +182,27 @@ func (d *Day20) Part2(raceTrack Maze, threshold int) int {
 func (d *Day20) GetMazePath(raceTrack Maze, start MazePoint, end MazePoint) []MazePoint {
 	currentLocation := start
 
-	// use RaceTrackPosition since MazePoint includes a pointCost that might cause issues doing lookups
-	startPosition := RaceTrackPosition{Y: start.Y, X: start.X}
-
-	// keeps track of the positions visited
-	visited := make(map[RaceTrackPosition]bool)
-	visited[startPosition] = true
+	// keeps track of the positions visited (keyed by the position alone, since a MazePoint
+	// includes a pointCost that might cause issues doing lookups)
+	visited := make(map[geometry.Point]bool)
+	visited[start.Point] = true
 	// builds the path that works
 	var path []MazePoint
 	path = append(path, start)
 
 	distance := 0
-	for currentLocation.X != end.X || currentLocation.Y != end.Y {
+	for currentLocation.Point != end.Point {
 		// the next position will be 1 position further from the start
 		distance++
 
-		for _, direction := range directionDeltas {
-			newY, newX := currentLocation.Y+direction.dy, currentLocation.X+direction.dx
-			newPosition := RaceTrackPosition{Y: newY, X: newX}
+		for _, direction := range geometry.Directions {
+			newPosition := currentLocation.Move(direction)
 
 			// check whether we can move in each direction to an open, non-visited point on the track
-			if raceTrack[newY][newX].val != '#' && !visited[newPosition] {
+			if raceTrack.At(newPosition.X, newPosition.Y) != '#' && !visited[newPosition] {
 				visited[newPosition] = true
 
-				newLocation := MazePoint{Y: newY, X: newX, pointCost: distance}
+				newLocation := MazePoint{Point: newPosition, pointCost: distance}
 				path = append(path, newLocation)
 
 				currentLocation = newLocation
@@ -214,21 +223,8 @@ func absInt(x int) int {
 	return x
 }
 
-// parseInput
-func (d *Day20) parseInput(input []string) Maze {
-	maze := make([][]MazeLocation, len(input))
-
-	for i, line := range input {
-		row := make([]MazeLocation, len(line))
-
-		for j, char := range line {
-			row[j] = MazeLocation{
-				val: char,
-			}
-		}
-
-		maze[i] = row
-	}
-
-	return maze
+// parseInput converts the input into a Maze. An error is returned if the input isn't a
+// grid or is missing the start (S) or end (E) position.
+func (d *Day20) parseInput(input []string) (Maze, error) {
+	return parseMaze(input)
 }
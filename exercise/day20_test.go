@@ -25,7 +25,11 @@ func TestDay20Part1(t *testing.T) {
 
 	d20 := Day20{}
 
-	raceTrack := d20.parseInput(input)
+	raceTrack, err := d20.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	numCheats := d20.Part1(raceTrack, 20)
 	expectedNumcheats := 5
@@ -56,7 +60,11 @@ func TestDay20Part2(t *testing.T) {
 
 	d20 := Day20{}
 
-	raceTrack := d20.parseInput(input)
+	raceTrack, err := d20.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	numCheats := d20.Part2(raceTrack, 70)
 	expectedNumCheats := 41
@@ -2,12 +2,13 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"strconv"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
 )
 
 type (
@@ -17,43 +18,64 @@ type (
 		file string
 	}
 
-	KeyLocation struct {
-		x, y int
-	}
-
-	Keypad map[string]KeyLocation
+	// Keypad is the position of each key of a keypad, with 0, 0 the top-left key
+	Keypad map[string]geometry.Point
 )
 
+// init registers the Day 21 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 21, Title: "Keypad Conundrum", File: "data/2024/day21/input.txt"}, func(name, file string) Exercise {
+		return &Day21{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 21 exercise
 func (d *Day21) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 21 exercise
+func (d *Day21) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 21 by retrieving the default file contents and uses that data
-func (d *Day21) Run(w io.Writer) {
+func (d *Day21) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day 21 solution using the provided input data and returns the answers
+func (d *Day21) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// RunFromInput executs the Day 21 solution using the provided input data
-func (d *Day21) RunFromInput(w io.Writer, input []string) {
-	// part 1
-	sumCodeComplexity := d.CalculateComplexity(input, 2)
-	w.Write([]byte(fmt.Sprintf("Day 21 - Part 1 - The sum of the complexity of the provided codes (2 robots) is %d.\n", sumCodeComplexity)))
+// RunPart executes the specified part of the Day 21 solution using the provided input data and
+// returns the answer
+func (d *Day21) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	codes, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		sumCodeComplexity := d.CalculateComplexity(codes, 2)
+		return Answer{Part: 1, Label: "The sum of the complexity of the provided codes (2 robots)", Value: sumCodeComplexity}, nil
+	case 2:
+		sumCodeComplexity := d.CalculateComplexity(codes, 25)
+		return Answer{Part: 2, Label: "The sum of the complexity of the provided codes (25 robots)", Value: sumCodeComplexity}, nil
+	}
 
-	// part 2
-	sumCodeComplexity = d.CalculateComplexity(input, 25)
-	w.Write([]byte(fmt.Sprintf("Day 21 - Part 2 - The sum of the complexity of the provided codes (25 robots) is %d.\n", sumCodeComplexity)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // CalculateComplexity determines the complexity calculation by finding the shortest
@@ -70,26 +92,26 @@ func (d *Day21) RunFromInput(w io.Writer, input []string) {
 func (d *Day21) CalculateComplexity(keypadCodes []string, numRobots int) int {
 	// the numeric keypad
 	numMap := Keypad{
-		"0": {1, 0},
-		"A": {2, 0},
-		"1": {0, 1},
-		"2": {1, 1},
-		"3": {2, 1},
-		"4": {0, 2},
-		"5": {1, 2},
-		"6": {2, 2},
-		"7": {0, 3},
-		"8": {1, 3},
-		"9": {2, 3},
+		"7": {X: 0, Y: 0},
+		"8": {X: 1, Y: 0},
+		"9": {X: 2, Y: 0},
+		"4": {X: 0, Y: 1},
+		"5": {X: 1, Y: 1},
+		"6": {X: 2, Y: 1},
+		"1": {X: 0, Y: 2},
+		"2": {X: 1, Y: 2},
+		"3": {X: 2, Y: 2},
+		"0": {X: 1, Y: 3},
+		"A": {X: 2, Y: 3},
 	}
 
 	// the directional keypad
 	dirMap := Keypad{
-		"^": {1, 1},
-		"A": {2, 1},
-		"<": {0, 0},
-		"v": {1, 0},
-		">": {2, 0},
+		"^": {X: 1, Y: 0},
+		"A": {X: 2, Y: 0},
+		"<": {X: 0, Y: 1},
+		"v": {X: 1, Y: 1},
+		">": {X: 2, Y: 1},
 	}
 
 	sumCodeComplexity := 0
@@ -108,6 +130,18 @@ func (d *Day21) CalculateComplexity(keypadCodes []string, numRobots int) int {
 	return sumCodeComplexity
 }
 
+// parseInput validates that each line of the input is a door code made up of three digits
+// followed by an 'A' (e.g. 029A) and returns the codes
+func (d *Day21) parseInput(input []string) ([]string, error) {
+	for i, code := range input {
+		if len(code) != 4 || code[3] != 'A' || strings.IndexFunc(code[:3], func(r rune) bool { return r < '0' || r > '9' }) >= 0 {
+			return nil, fileprocessing.NewParseError(i+1, code, fmt.Errorf("expected a code of three digits followed by 'A'"))
+		}
+	}
+
+	return input, nil
+}
+
 // doorSequence takes the specified input that needs to be typed on the door and returns
 // the robot keypad sequence that will build it
 func doorSequence(input string, start string, numMap Keypad) string {
@@ -118,36 +152,16 @@ func doorSequence(input string, start string, numMap Keypad) string {
 
 	for _, char := range chars {
 		destination := numMap[char]
-		dx, dy := destination.x-current.x, destination.y-current.y
-
-		horizontal, vertical := "", ""
-
-		// build horizontal moves
-		for i := 0; i < absInt(dx); i++ {
-			if dx >= 0 {
-				horizontal += string('>')
-			} else {
-				horizontal += string('<')
-			}
-		}
-
-		// build vertical moves
-		for i := 0; i < absInt(dy); i++ {
-			if dy >= 0 {
-				vertical += string('^')
-			} else {
-				vertical += string('v')
-			}
-		}
+		horizontal, vertical := keypadMoves(destination.Sub(current))
 
 		// avoid the empty spot
-		if current.y == 0 && destination.x == 0 {
+		if current.Y == 3 && destination.X == 0 {
 			sequence += vertical
 			sequence += horizontal
-		} else if current.x == 0 && destination.y == 0 {
+		} else if current.X == 0 && destination.Y == 3 {
 			sequence += horizontal
 			sequence += vertical
-		} else if dx < 0 {
+		} else if destination.X < current.X {
 			sequence += horizontal
 			sequence += vertical
 		} else {
@@ -162,6 +176,22 @@ func doorSequence(input string, start string, numMap Keypad) string {
 	return sequence
 }
 
+// keypadMoves returns the horizontal and vertical moves (as the arrows of the directional
+// keypad) that move by delta on a keypad
+func keypadMoves(delta geometry.Point) (string, string) {
+	horizontal, vertical := geometry.East, geometry.South
+	if delta.X < 0 {
+		horizontal = geometry.West
+	}
+
+	if delta.Y < 0 {
+		vertical = geometry.North
+	}
+
+	return strings.Repeat(string(horizontal.Arrow()), absInt(delta.X)),
+		strings.Repeat(string(vertical.Arrow()), absInt(delta.Y))
+}
+
 // robotSequence takes the specified input that needs to be typed on the robot keypad and returns
 // the robot keypad sequence that will build it
 func robotSequence(input string, start string, dirMap Keypad) string {
@@ -172,37 +202,16 @@ func robotSequence(input string, start string, dirMap Keypad) string {
 
 	for _, char := range chars {
 		destination := dirMap[char]
-		dx := destination.x - current.x
-		dy := destination.y - current.y
-
-		horizontal, vertical := "", ""
-
-		// build horizontal moves
-		for i := 0; i < absInt(dx); i++ {
-			if dx >= 0 {
-				horizontal += string('>')
-			} else {
-				horizontal += string('<')
-			}
-		}
-
-		// build vertical moves
-		for i := 0; i < absInt(dy); i++ {
-			if dy >= 0 {
-				vertical += string('^')
-			} else {
-				vertical += string('v')
-			}
-		}
+		horizontal, vertical := keypadMoves(destination.Sub(current))
 
 		// avoid the empty spot
-		if current.x == 0 && destination.y == 1 {
+		if current.X == 0 && destination.Y == 0 {
 			sequence += horizontal
 			sequence += vertical
-		} else if current.y == 1 && destination.x == 0 {
+		} else if current.Y == 0 && destination.X == 0 {
 			sequence += vertical
 			sequence += horizontal
-		} else if dx < 0 {
+		} else if destination.X < current.X {
 			sequence += horizontal
 			sequence += vertical
 		} else {
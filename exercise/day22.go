@@ -2,8 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"math"
 	"strconv"
 
@@ -18,43 +18,66 @@ type (
 	}
 )
 
+// init registers the Day 22 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 22, Title: "Monkey Market", File: "data/2024/day22/input.txt"}, func(name, file string) Exercise {
+		return &Day22{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 22 exercise
 func (d *Day22) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 22 exercise
+func (d *Day22) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 22 by retrieving the default file contents and uses that data
-func (d *Day22) Run(w io.Writer) {
+func (d *Day22) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 22 solution using the provided input data
-func (d *Day22) RunFromInput(w io.Writer, input []string) {
-	// part 1
-	sumSecretNumbers := d.Part1(input, 2000)
-	w.Write([]byte(fmt.Sprintf("Day 22 - Part 1 - The sum of the secret numbers after 2000 generations is %d.\n", sumSecretNumbers)))
+// RunFromInput executes the Day 22 solution using the provided input data and returns the answers
+func (d *Day22) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 22 solution using the provided input data and
+// returns the answer
+func (d *Day22) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	secretNumbers, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		sumSecretNumbers := d.Part1(secretNumbers, 2000)
+		return Answer{Part: 1, Label: "The sum of the secret numbers after 2000 generations", Value: sumSecretNumbers}, nil
+	case 2:
+		maxBananas := d.Part2(secretNumbers, 2000)
+		return Answer{Part: 2, Label: "The maximum number of bananas we can get from the specified buyers", Value: maxBananas}, nil
+	}
 
-	// part 2
-	maxBananas := d.Part2(input, 2000)
-	w.Write([]byte(fmt.Sprintf("Day 22 - Part 2 - The maximum number of bananas we can get from the specified buyers is %d.\n", maxBananas)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 calculates the secret numbers after 2000 generations
-func (d *Day22) Part1(secretNumbers []string, numGenerations int) int {
+func (d *Day22) Part1(secretNumbers []int, numGenerations int) int {
 	sumSecretNumbers := 0
-	for _, secretNumber := range secretNumbers {
-		secret, _ := strconv.Atoi(secretNumber)
+	for _, secret := range secretNumbers {
 		for i := 0; i < numGenerations; i++ {
 			secret = calculateSecret(secret)
 		}
@@ -67,13 +90,11 @@ func (d *Day22) Part1(secretNumbers []string, numGenerations int) int {
 
 // Part2 determines the maximum number of bananas we can retrieve from providing a sequence
 // of changes to the monkey sellers
-func (d *Day22) Part2(secretNumbers []string, numGenerations int) int {
+func (d *Day22) Part2(secretNumbers []int, numGenerations int) int {
 	// stores the bananas that would result from the sequence of changes (the key)
 	sequencesDiscovered := make(map[[4]int]int)
 
-	for _, secretNumber := range secretNumbers {
-		secret, _ := strconv.Atoi(secretNumber)
-
+	for _, secret := range secretNumbers {
 		// the changes that correspond to the current secret value
 		changes := make(map[[4]int]bool)
 
@@ -115,6 +136,22 @@ func (d *Day22) Part2(secretNumbers []string, numGenerations int) int {
 	return maxBananas
 }
 
+// parseInput converts each line of the input into the buyer's initial secret number
+func (d *Day22) parseInput(input []string) ([]int, error) {
+	secretNumbers := make([]int, 0, len(input))
+
+	for i, line := range input {
+		secret, err := strconv.Atoi(line)
+		if err != nil {
+			return nil, fileprocessing.NewParseError(i+1, line, err)
+		}
+
+		secretNumbers = append(secretNumbers, secret)
+	}
+
+	return secretNumbers, nil
+}
+
 // calculateSecret calculates the secret number according to the rules specified in the assignment:
 //   - Calculate the result of multiplying the secret number by 64. Then, mix this result into the
 //     secret number. Finally, prune the secret number.
@@ -1,15 +1,19 @@
 package exercise
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
 )
 
 func TestDay22Part1(t *testing.T) {
-	input := []string{
-		"1",
-		"10",
-		"100",
-		"2024",
+	input := []int{
+		1,
+		10,
+		100,
+		2024,
 	}
 
 	d22 := Day22{}
@@ -24,11 +28,11 @@ func TestDay22Part1(t *testing.T) {
 }
 
 func TestDay22Part2(t *testing.T) {
-	input := []string{
-		"1",
-		"2",
-		"3",
-		"2024",
+	input := []int{
+		1,
+		2,
+		3,
+		2024,
 	}
 
 	d22 := Day22{}
@@ -41,3 +45,21 @@ func TestDay22Part2(t *testing.T) {
 		t.Errorf("Day 22 - Part 2 (number of bananas) Test:\nwant %v\ngot %v\n", expectedBananas, bananas)
 	}
 }
+
+func TestDay22ParseError(t *testing.T) {
+	input := []string{
+		"1",
+		"10",
+		"1O0",
+		"2024",
+	}
+
+	d22 := Day22{}
+
+	_, err := d22.RunFromInput(context.Background(), input)
+
+	var parseErr *fileprocessing.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 3 {
+		t.Errorf("Day 22 - Parse Error Test:\nwant an error on line %v\ngot %v\n", 3, err)
+	}
+}
@@ -2,9 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
 	"sort"
 	"strings"
 
@@ -24,74 +23,105 @@ type (
 	}
 )
 
+// init registers the Day 23 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 23, Title: "LAN Party", File: "data/2024/day23/input.txt"}, func(name, file string) Exercise {
+		return &Day23{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 23 exercise
 func (d *Day23) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 23 exercise
+func (d *Day23) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 23 by retrieving the default file contents and uses that data
-func (d *Day23) Run(w io.Writer) {
+func (d *Day23) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 23 solution using the provided input data
-func (d *Day23) RunFromInput(w io.Writer, input []string) {
-	// part 1
-	numSets := d.Part1(input)
-	w.Write([]byte(fmt.Sprintf("Day 23 - Part 1 - The number of three inter-connected computers that start with 't' is %d.\n", numSets)))
+// RunFromInput executes the Day 23 solution using the provided input data and returns the answers
+func (d *Day23) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 23 solution using the provided input data and
+// returns the answer
+func (d *Day23) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	computerGraph, err := NewComputerGraph(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		numSets := d.Part1(computerGraph)
+		return Answer{Part: 1, Label: "The number of three inter-connected computers that start with 't'", Value: numSets}, nil
+	case 2:
+		connectedComputers := d.Part2(computerGraph)
+		return Answer{Part: 2, Label: "The password to the LAN party", Value: connectedComputers}, nil
+	}
 
-	// part 2
-	connectedComputers := d.Part2(input)
-	w.Write([]byte(fmt.Sprintf("Day 23 - Part 2 - The connected computers are %s.\n", connectedComputers)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 computers the number of interconnected computers where at least one computer starts with 't'
-func (d *Day23) Part1(input []string) int {
-	computerGraph := NewComputerGraph(input)
+func (d *Day23) Part1(computerGraph *ComputerGraph) int {
 	setsOfThree := computerGraph.FindTrianglesThatStartWith('t')
 
 	return len(setsOfThree)
 }
 
-// Part2
-func (d *Day23) Part2(input []string) string {
-	computerGraph := NewComputerGraph(input)
+// Part2 finds the largest set of computers that are all connected to each other and returns
+// their names sorted and joined by commas, which is the password to the LAN party
+func (d *Day23) Part2(computerGraph *ComputerGraph) string {
 	largestSet := computerGraph.FindLargestConnectedSet()
 	sort.Strings(largestSet)
 	return strings.Join(largestSet, ",")
 }
 
 // NewComputerGraph takes the input and builds a graph of the computers and their
-// connections
-func NewComputerGraph(input []string) *ComputerGraph {
+// connections. An error is returned if a line isn't a pair of connected computers.
+func NewComputerGraph(input []string) (*ComputerGraph, error) {
+	if len(input) == 0 {
+		return nil, fmt.Errorf("there are no connections")
+	}
+
 	computerGraph := ComputerGraph{
 		adjacency: make(map[string]map[string]bool),
 	}
 
-	for _, pair := range input {
+	for i, pair := range input {
 		nodes := strings.Split(pair, "-")
 		if len(nodes) != 2 {
-			log.Fatalf("Invalid pair: %s", pair)
+			return nil, fileprocessing.NewParseError(i+1, pair, fmt.Errorf("expected a pair of computers of the form a-b"))
 		}
 
 		node1 := strings.TrimSpace(nodes[0])
 		node2 := strings.TrimSpace(nodes[1])
 
+		if node1 == "" || node2 == "" {
+			return nil, fileprocessing.NewParseError(i+1, pair, fmt.Errorf("the computer name is empty"))
+		}
+
 		computerGraph.AddEdge(node1, node2)
 	}
 
-	return &computerGraph
+	return &computerGraph, nil
 }
 
 // AddEdge adds an undirected edge between node1 and node2
@@ -42,7 +42,13 @@ func TestDay23Part1(t *testing.T) {
 
 	d23 := Day23{}
 
-	numInterconnectedComputersWithT := d23.Part1(input)
+	computerGraph, err := NewComputerGraph(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
+	numInterconnectedComputersWithT := d23.Part1(computerGraph)
 
 	expectedNumInterconnectedComputersWithT := 7
 
@@ -89,7 +95,13 @@ func TestDay23Part2(t *testing.T) {
 
 	d23 := Day23{}
 
-	largestSet := d23.Part2(input)
+	computerGraph, err := NewComputerGraph(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
+	largestSet := d23.Part2(computerGraph)
 
 	expectedLargestSet := "co,de,ka,ta"
 
@@ -3,9 +3,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
 	"sort"
 	"strconv"
 	"strings"
@@ -40,35 +39,60 @@ const (
 	XOR
 )
 
+// init registers the Day 24 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 24, Title: "Crossed Wires", File: "data/2024/day24/input.txt"}, func(name, file string) Exercise {
+		return &Day24{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 24 exercise
 func (d *Day24) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 24 exercise
+func (d *Day24) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 24 by retrieving the default file contents and uses that data
-func (d *Day24) Run(w io.Writer) {
+func (d *Day24) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 24 solution using the provided input data
-func (d *Day24) RunFromInput(w io.Writer, input []string) {
-	bits, instructions := d.parseInput(input)
-	zVal := d.Part1(bits, instructions)
-	w.Write([]byte(fmt.Sprintf("Day 24 - Part 1 - The value of the wires that start with 'z' is %d.\n", zVal)))
+// RunFromInput executes the Day 24 solution using the provided input data and returns the answers
+func (d *Day24) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 24 solution using the provided input data and
+// returns the answer
+func (d *Day24) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	bits, instructions, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		zVal := d.Part1(bits, instructions)
+		return Answer{Part: 1, Label: "The value of the wires that start with 'z'", Value: zVal}, nil
+	case 2:
+		swappedRegisters := d.Part2(bits, instructions)
+		return Answer{Part: 2, Label: "The swapped registers", Value: swappedRegisters}, nil
+	}
 
-	swappedRegisters := d.Part2(bits, instructions)
-	w.Write([]byte(fmt.Sprintf("Day 24 - Part 2 - The swapped registers are %s.\n", swappedRegisters)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 runs the instructions (after their constituent wires have been loaded with values)
@@ -102,75 +126,72 @@ func (d *Day24) Part2(bits Bits, instructions []Instruction) string {
 }
 
 // parseInput takes the specified input and produces a Bits map and a slice of
-// Instructions to run
-func (d *Day24) parseInput(input []string) (Bits, []Instruction) {
+// Instructions to run. An error is returned if a wire value or gate can't be parsed.
+func (d *Day24) parseInput(input []string) (Bits, []Instruction, error) {
 	bits := make(Bits)
 	var instructions []Instruction
 
-	parsingBits := true
+	// the wire values are separated from the gates by a blank line
+	sections, err := fileprocessing.Sections(input, 2)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	for _, line := range input {
-		line = strings.TrimSpace(line)
+	wires, err := sections[0].KeyValues()
+	if err != nil {
+		return nil, nil, err
+	}
 
-		if line == "" {
-			parsingBits = false
-			continue
+	for _, wire := range wires {
+		value, err := wire.Int()
+		if err != nil {
+			return nil, nil, err
 		}
+		bits[wire.Key] = (value != 0)
+	}
 
-		if parsingBits {
-			parts := strings.Split(line, ":")
-			if len(parts) != 2 {
-				log.Fatalf("invalid input")
-			}
-			key := strings.TrimSpace(parts[0])
-			valueStr := strings.TrimSpace(parts[1])
-			value, err := strconv.Atoi(valueStr)
-			if err != nil {
-				log.Fatalf("invalid input")
-			}
-			bits[key] = (value != 0)
-		} else {
-			// x00 AND y00 -> z00
+	for i, line := range sections[1].Lines {
+		// x00 AND y00 -> z00
+		line = strings.TrimSpace(line)
 
-			parts := strings.Split(line, "->")
-			if len(parts) != 2 {
-				log.Fatalf("invalid input")
-			}
-			operationPart := strings.TrimSpace(parts[0])
-			destination := strings.TrimSpace(parts[1])
+		parts := strings.Split(line, "->")
+		if len(parts) != 2 {
+			return nil, nil, sections[1].LineErr(i, fmt.Errorf("expected a gate of the form x00 AND y00 -> z00"))
+		}
+		operationPart := strings.TrimSpace(parts[0])
+		destination := strings.TrimSpace(parts[1])
 
-			tokens := strings.Fields(operationPart)
-			if len(tokens) != 3 {
-				log.Fatalf("invalid input")
-			}
+		tokens := strings.Fields(operationPart)
+		if len(tokens) != 3 || destination == "" {
+			return nil, nil, sections[1].LineErr(i, fmt.Errorf("expected a gate of the form x00 AND y00 -> z00"))
+		}
 
-			source0 := tokens[0]
-			opStr := strings.ToUpper(tokens[1])
-			source1 := tokens[2]
-
-			// map the operation string to the corresponding operator
-			var op int
-			switch opStr {
-			case "AND":
-				op = AND
-			case "OR":
-				op = OR
-			case "XOR":
-				op = XOR
-			default:
-				log.Fatalf("invalid input")
-			}
+		source0 := tokens[0]
+		opStr := strings.ToUpper(tokens[1])
+		source1 := tokens[2]
+
+		// map the operation string to the corresponding operator
+		var op int
+		switch opStr {
+		case "AND":
+			op = AND
+		case "OR":
+			op = OR
+		case "XOR":
+			op = XOR
+		default:
+			return nil, nil, sections[1].LineErr(i, fmt.Errorf("unknown operation %s", opStr))
+		}
 
-			instr := Instruction{
-				Source:      [2]string{source0, source1},
-				Destination: destination,
-				Operation:   op,
-			}
-			instructions = append(instructions, instr)
+		instr := Instruction{
+			Source:      [2]string{source0, source1},
+			Destination: destination,
+			Operation:   op,
 		}
+		instructions = append(instructions, instr)
 	}
 
-	return bits, instructions
+	return bits, instructions, nil
 }
 
 // getZVal constructs the decimal value of all bits whose wire name starts with 'z' at
@@ -19,7 +19,11 @@ func TestDay24Part1(t *testing.T) {
 	}
 
 	d24 := Day24{}
-	bits, instructions := d24.parseInput(input)
+	bits, instructions, err := d24.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	zResult := d24.Part1(bits, instructions)
 
@@ -82,7 +86,11 @@ func TestDay24PartLarger(t *testing.T) {
 	}
 
 	d24 := Day24{}
-	bits, instructions := d24.parseInput(input)
+	bits, instructions, err := d24.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	zResult := d24.Part1(bits, instructions)
 
@@ -117,7 +125,11 @@ func TestDay24Part2(t *testing.T) {
 	}
 
 	d24 := Day24{}
-	bits, instructions := d24.parseInput(input)
+	bits, instructions, err := d24.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	result := d24.Part2(bits, instructions)
 
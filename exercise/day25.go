@@ -2,10 +2,11 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -19,37 +20,61 @@ type (
 	Locks []Schematic
 
 	Schematic struct {
-		val     [][]rune
+		val     *grid.Grid[rune]
 		heights []int
 	}
 )
 
+// init registers the Day 25 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 25, Title: "Code Chronicle", File: "data/2024/day25/input.txt"}, func(name, file string) Exercise {
+		return &Day25{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 25 exercise
 func (d *Day25) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 25 exercise
+func (d *Day25) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 25 by retrieving the default file contents and uses that data
-func (d *Day25) Run(w io.Writer) {
+func (d *Day25) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day 25 solution using the provided input data and returns the answers
+func (d *Day25) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1)
 }
 
-// RunFromInput executs the Day 25 solution using the provided input data
-func (d *Day25) RunFromInput(w io.Writer, input []string) {
-	locks, keys := d.parseInput(input)
+// RunPart executes the specified part of the Day 25 solution using the provided input data and
+// returns the answer. Day 25 only has a single part.
+func (d *Day25) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	if part != 1 {
+		return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
+	}
+
+	locks, keys, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
 	fits := d.Part1(locks, keys)
-	w.Write([]byte(fmt.Sprintf("Day 25 - Part 1 - The number of fits between locks and keys is %d.\n", fits)))
+	return Answer{Part: 1, Label: "The number of fits between locks and keys", Value: fits}, nil
 }
 
 // Part1 determines how many fits there are between the specified locks and keys. A fit is when
@@ -83,55 +108,60 @@ func (d *Day25) Part2() int {
 	return 0
 }
 
-// parseInput parses the input into Locks and Keys
-func (d *Day25) parseInput(input []string) (Locks, Keys) {
+// parseInput parses the input into Locks and Keys. An error is returned if a schematic
+// isn't 7 rows of 5 columns or is neither a lock nor a key.
+func (d *Day25) parseInput(input []string) (Locks, Keys, error) {
 	var (
-		locks            []Schematic
-		keys             []Schematic
-		currentSchematic Schematic
+		locks []Schematic
+		keys  []Schematic
 	)
 
-	for i, line := range input {
-		if line == "" {
-			// process the completed schematic
-			if len(currentSchematic.val) > 0 {
-				currentSchematic.heights = calculateHeights(currentSchematic.val)
-				if isLock(currentSchematic) {
-					locks = append(locks, currentSchematic)
-				} else if isKey(currentSchematic) {
-					keys = append(keys, currentSchematic)
-				}
-			}
+	// each schematic is a block of lines separated from the next by a blank line
+	for _, block := range fileprocessing.Blocks(input) {
+		val, err := grid.Parse(block.Lines)
+		if err != nil {
+			return nil, nil, block.Err(err)
+		}
 
-			// Reset for the next schematic
-			currentSchematic = Schematic{}
-			continue
+		if err := addSchematic(Schematic{val: val}, &locks, &keys); err != nil {
+			return nil, nil, block.LineErr(0, err)
 		}
+	}
 
-		// add the current line to the schematic
-		currentSchematic.val = append(currentSchematic.val, []rune(line))
+	return locks, keys, nil
+}
 
-		// handle the last schematic
-		if i == len(input)-1 && len(currentSchematic.val) > 0 {
-			currentSchematic.heights = calculateHeights(currentSchematic.val)
-			if isLock(currentSchematic) {
-				locks = append(locks, currentSchematic)
-			} else if isKey(currentSchematic) {
-				keys = append(keys, currentSchematic)
-			}
-		}
+// addSchematic calculates the heights of the specified schematic and appends it to either
+// the locks or the keys. An error is returned if the schematic isn't 7 rows of 5 columns or
+// is neither a lock nor a key.
+func addSchematic(s Schematic, locks *[]Schematic, keys *[]Schematic) error {
+	if s.val.Height() != 7 {
+		return fmt.Errorf("expected a schematic of 7 rows, got %d", s.val.Height())
+	}
+
+	if s.val.Width() != 5 {
+		return fmt.Errorf("expected a schematic of 5 columns, got %d", s.val.Width())
+	}
+
+	s.heights = calculateHeights(s.val)
+	if isLock(s) {
+		*locks = append(*locks, s)
+	} else if isKey(s) {
+		*keys = append(*keys, s)
+	} else {
+		return fmt.Errorf("the schematic is neither a lock nor a key")
 	}
 
-	return locks, keys
+	return nil
 }
 
 // Helper to check if a schematic is a lock
 func isLock(s Schematic) bool {
-	if len(s.val) == 0 {
+	if s.val.Height() == 0 {
 		return false
 	}
 
-	for _, cell := range s.val[0] {
+	for _, cell := range s.val.Row(0) {
 		if cell != '#' {
 			return false
 		}
@@ -142,11 +172,11 @@ func isLock(s Schematic) bool {
 
 // isKey checks if a schematic is a key
 func isKey(s Schematic) bool {
-	if len(s.val) == 0 {
+	if s.val.Height() == 0 {
 		return false
 	}
 
-	bottomRow := s.val[len(s.val)-1]
+	bottomRow := s.val.Row(s.val.Height() - 1)
 	for _, cell := range bottomRow {
 		if cell != '#' {
 			return false
@@ -158,16 +188,16 @@ func isKey(s Schematic) bool {
 
 // calculateHeights calculates the heights of each column in a schematic (number of '#' values
 // in each column excluding first and last rows)
-func calculateHeights(val [][]rune) []int {
-	if len(val) <= 2 {
+func calculateHeights(val *grid.Grid[rune]) []int {
+	if val.Height() <= 2 {
 		return []int{}
 	}
 
-	heights := make([]int, len(val[0]))
-	for i := 1; i < len(val)-1; i++ {
-		for j, cell := range val[i] {
+	heights := make([]int, val.Width())
+	for x, column := range val.Columns() {
+		for _, cell := range column[1 : len(column)-1] {
 			if cell == '#' {
-				heights[j]++
+				heights[x]++
 			}
 		}
 	}
@@ -49,7 +49,11 @@ func TestDay25Part1(t *testing.T) {
 
 	d25 := Day25{}
 
-	locks, keys := d25.parseInput(input)
+	locks, keys, err := d25.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 	keysThatFitLocks := d25.Part1(locks, keys)
 
 	expectedKeysThatFitLocks := 3
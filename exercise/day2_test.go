@@ -1,7 +1,11 @@
 package exercise
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
 )
 
 func TestDay2CountSafeReports(t *testing.T) {
@@ -74,3 +78,18 @@ func TestDay2CountSafeReportsWithOneRemoval(t *testing.T) {
 		t.Errorf("Day 2 - Part 2 (second set) Test:\nwant %v\ngot %v\n", expectedValue, calculatedValue)
 	}
 }
+
+func TestDay2ParseError(t *testing.T) {
+	d2 := Day2{}
+
+	for _, line := range []string{"7 6 4 2 1x", "7,6,4,2,1"} {
+		input := []string{"1 2 7 8 9", line}
+
+		_, err := d2.RunFromInput(context.Background(), input)
+
+		var parseErr *fileprocessing.ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+			t.Errorf("Day 2 - Parse Error Test: %q\nwant an error on line %v\ngot %v\n", line, 2, err)
+		}
+	}
+}
@@ -2,8 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"regexp"
 	"strconv"
 
@@ -23,50 +23,65 @@ type (
 	}
 )
 
+// init registers the Day 3 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 3, Title: "Mull It Over", File: "data/2024/day3/input.txt"}, func(name, file string) Exercise {
+		return &Day3{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 3 exercise
 func (d *Day3) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 3 exercise
+func (d *Day3) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 3 by retrieving the default file contents and uses that data
-func (d *Day3) Run(w io.Writer) {
+func (d *Day3) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 3 solution using the provided input data
-func (d *Day3) RunFromInput(w io.Writer, input []string) {
-	instructions, err := d.parseInputRaw(input)
-	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
-	}
+// RunFromInput executes the Day 3 solution using the provided input data and returns the answers
+func (d *Day3) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	sumOfMultiplications := d.Part1(instructions)
+// RunPart executes the specified part of the Day 3 solution using the provided input data and
+// returns the answer
+func (d *Day3) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	switch part {
+	case 1:
+		instructions, err := d.parseInputRaw(input)
+		if err != nil {
+			return Answer{Part: 1}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+		}
 
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 3 - Part 1 - The sum of mul() instructions is %d.\n", sumOfMultiplications)))
+		sumOfMultiplications := d.Part1(instructions)
+		return Answer{Part: 1, Label: "The sum of mul() instructions", Value: sumOfMultiplications}, nil
+	case 2:
+		instructions, err := d.parseInputApplyConditionals(input)
+		if err != nil {
+			return Answer{Part: 2}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+		}
 
-	instructions, err = d.parseInputApplyConditionals(input)
-	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
+		sumOfMultiplications := d.Part2(instructions)
+		return Answer{Part: 2, Label: "The sum of enabled mul() instructions", Value: sumOfMultiplications}, nil
 	}
 
-	sumOfMultiplications = d.Part2(instructions)
-
-	// part 2
-	w.Write([]byte(fmt.Sprintf("Day 3 - Part 2 - The sum of mul() instructions is %d.\n", sumOfMultiplications)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 iterates over the instructions and returns the sum of the instruction multiples
@@ -103,16 +118,20 @@ func (d *Day3) parseInputRaw(input []string) ([]instruction, error) {
 
 	// Iterate over matches and parse them into instructions
 	for _, match := range matches {
-		v1, err1 := strconv.Atoi(match[1])
-		v2, err2 := strconv.Atoi(match[2])
-		if err1 == nil && err2 == nil {
-			instructions = append(instructions, instruction{
-				v1: v1,
-				v2: v2,
-			})
-		} else {
-			return instructions, fmt.Errorf("There was an error parsing the input")
+		v1, err := strconv.Atoi(match[1])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing instruction %s: %w", match[0], err)
+		}
+
+		v2, err := strconv.Atoi(match[2])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing instruction %s: %w", match[0], err)
 		}
+
+		instructions = append(instructions, instruction{
+			v1: v1,
+			v2: v2,
+		})
 	}
 
 	return instructions, nil
@@ -150,14 +169,20 @@ func (d *Day3) parseInputApplyConditionals(input []string) ([]instruction, error
 			ignore = false
 		} else if mulRe.MatchString(match[0]) && !ignore {
 			// If a valid mul(x, y) is encountered and not ignoring
-			v1, err1 := strconv.Atoi(match[1])
-			v2, err2 := strconv.Atoi(match[2])
-			if err1 == nil && err2 == nil {
-				instructions = append(instructions, instruction{
-					v1: v1,
-					v2: v2,
-				})
+			v1, err := strconv.Atoi(match[1])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing instruction %s: %w", match[0], err)
+			}
+
+			v2, err := strconv.Atoi(match[2])
+			if err != nil {
+				return nil, fmt.Errorf("error parsing instruction %s: %w", match[0], err)
 			}
+
+			instructions = append(instructions, instruction{
+				v1: v1,
+				v2: v2,
+			})
 		}
 	}
 
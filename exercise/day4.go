@@ -2,11 +2,12 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -15,126 +16,87 @@ type (
 		name string
 		file string
 	}
-
-	coordinate struct {
-		x int
-		y int
-	}
 )
 
+// init registers the Day 4 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 4, Title: "Ceres Search", File: "data/2024/day4/input.txt"}, func(name, file string) Exercise {
+		return &Day4{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 4 exercise
 func (d *Day4) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 4 exercise
+func (d *Day4) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 4 by retrieving the default file contents and uses that data
-func (d *Day4) Run(w io.Writer) {
+func (d *Day4) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 4 solution using the provided input data
-func (d *Day4) RunFromInput(w io.Writer, input []string) {
-	grid := make([][]rune, len(input))
+// RunFromInput executes the Day 4 solution using the provided input data and returns the answers
+func (d *Day4) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	for i, str := range input {
-		// Convert each string into a slice of runes
-		grid[i] = []rune(str)
+// RunPart executes the specified part of the Day 4 solution using the provided input data and
+// returns the answer
+func (d *Day4) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	letters, err := grid.Parse(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	numberOfXmasInstances := d.Part1(grid)
+	switch part {
+	case 1:
+		numberOfXmasInstances := d.Part1(letters)
+		part1 := Answer{Part: 1, Label: "The number of 'XMAS' instances", Value: numberOfXmasInstances}
+		if numberOfXmasInstances < 0 {
+			part1.Err = fmt.Errorf("the grid is too small to search")
+		}
 
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 4 - Part 1 - The number of 'XMAS' instances is %d.\n", numberOfXmasInstances)))
+		return part1, nil
+	case 2:
+		numberOfMasXInstances := d.Part2(letters)
+		part2 := Answer{Part: 2, Label: "The number of 'MAS' in an X instances", Value: numberOfMasXInstances}
+		if numberOfMasXInstances < 0 {
+			part2.Err = fmt.Errorf("the grid is too small to search")
+		}
 
-	numberOfMasXInstances := d.Part2(grid)
+		return part2, nil
+	}
 
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 4 - Part 2 - The number of 'MAS' in an X instances is %d.\n", numberOfMasXInstances)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
-// Part1 counts the number of instances of the word 'XMAS' in the input character (rune) grid
-func (d *Day4) Part1(input [][]rune) int {
-	numColumns := len(input)
-	if numColumns == 0 {
-		log.Fatalf("invalid input")
+// Part1 counts the number of instances of the word 'XMAS' in the input character (rune) grid,
+// in any of the eight directions. -1 is returned if the grid is too small to search.
+func (d *Day4) Part1(input *grid.Grid[rune]) int {
+	if input.Height() == 0 || input.Width() < 4 {
+		return -1
 	}
 
-	numRows := len(input[0])
-	if numRows < 4 {
-		log.Fatalf("invalid input")
-	}
-
-	lengthTerm := len("XMAS")
-
 	countXMAS := 0
 
-	for y := 0; y < numColumns; y++ {
-		for x := 0; x < numRows; x++ {
-			// build coordinates for right
-			if x <= numRows-lengthTerm {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x + 1, y}, coordinate{x + 2, y}, coordinate{x + 3, y}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for left
-			if x >= lengthTerm-1 {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x - 1, y}, coordinate{x - 2, y}, coordinate{x - 3, y}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for up
-			if y >= lengthTerm-1 {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x, y - 1}, coordinate{x, y - 2}, coordinate{x, y - 3}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for down
-			if y <= numColumns-lengthTerm {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x, y + 1}, coordinate{x, y + 2}, coordinate{x, y + 3}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for up-left
-			if y >= lengthTerm-1 && x >= lengthTerm-1 {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x - 1, y - 1}, coordinate{x - 2, y - 2}, coordinate{x - 3, y - 3}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for down-left
-			if y <= numColumns-lengthTerm && x >= lengthTerm-1 {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x - 1, y + 1}, coordinate{x - 2, y + 2}, coordinate{x - 3, y + 3}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for down-right
-			if y <= numColumns-lengthTerm && x <= numRows-lengthTerm {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x + 1, y + 1}, coordinate{x + 2, y + 2}, coordinate{x + 3, y + 3}) {
-					countXMAS++
-				}
-			}
-
-			// build coordinates for up-right
-			if y >= lengthTerm-1 && x <= numRows-lengthTerm {
-				if d.matchXmas(input, coordinate{x, y}, coordinate{x + 1, y - 1}, coordinate{x + 2, y - 2}, coordinate{x + 3, y - 3}) {
-					countXMAS++
-				}
+	for _, x := range input.FindAll(grid.Equal('X')) {
+		for _, neighbour := range input.Neighbours8(x.X, x.Y) {
+			if d.matchXmas(input, x, neighbour.Sub(x)) {
+				countXMAS++
 			}
 		}
 	}
@@ -142,43 +104,31 @@ func (d *Day4) Part1(input [][]rune) int {
 	return countXMAS
 }
 
-// matchXmas determines whether the word 'XMAS' is found at the four specified coordinates of
-// the input grid
-func (d *Day4) matchXmas(input [][]rune, x, m, a, s coordinate) bool {
-	if input[x.y][x.x] != 'X' {
-		return false
-	}
-	if input[m.y][m.x] != 'M' {
-		return false
-	}
-	if input[a.y][a.x] != 'A' {
-		return false
-	}
-	if input[s.y][s.x] != 'S' {
-		return false
+// matchXmas determines whether the word 'XMAS' is found in the input grid starting at start
+// and moving by step
+func (d *Day4) matchXmas(input *grid.Grid[rune], start geometry.Point, step geometry.Point) bool {
+	for i, letter := range "XMAS" {
+		position := start.Add(step.Scale(i))
+		if r, ok := input.Get(position.X, position.Y); !ok || r != letter {
+			return false
+		}
 	}
+
 	return true
 }
 
-// Part2 counts the number of instances of the word 'MAS' that make an X
-func (d *Day4) Part2(input [][]rune) int {
-	numColumns := len(input)
-	if numColumns == 0 {
-		log.Fatalf("invalid input")
-	}
-
-	numRows := len(input[0])
-	if numRows < 4 {
-		log.Fatalf("invalid input")
+// Part2 counts the number of instances of the word 'MAS' that make an X. -1 is returned
+// if the grid is too small to search.
+func (d *Day4) Part2(input *grid.Grid[rune]) int {
+	if input.Height() == 0 || input.Width() < 4 {
+		return -1
 	}
 
 	countMAS := 0
 
-	for y := 1; y < numColumns-1; y++ {
-		for x := 1; x < numRows-1; x++ {
-			if d.matchMasX(input, coordinate{x, y}) {
-				countMAS++
-			}
+	for _, a := range input.FindAll(grid.Equal('A')) {
+		if d.matchMasX(input, a) {
+			countMAS++
 		}
 	}
 
@@ -187,15 +137,14 @@ func (d *Day4) Part2(input [][]rune) int {
 
 // matchMasX returns true if, at the given coordinate, the word 'MAS' makes an overlapping
 // X with itself ('MAS' and 'MAS', 'MAS' and 'SAM', 'SAM' and 'SAM', or 'SAM' and 'MAS')
-func (d *Day4) matchMasX(input [][]rune, a coordinate) bool {
-	if input[a.y][a.x] != 'A' {
+func (d *Day4) matchMasX(input *grid.Grid[rune], a geometry.Point) bool {
+	if a.X == 0 || a.Y == 0 || a.X == input.Width()-1 || a.Y == input.Height()-1 {
 		return false
 	}
 
-	if ((input[a.y-1][a.x-1] == 'M' && input[a.y+1][a.x+1] == 'S') || (input[a.y-1][a.x-1] == 'S' && input[a.y+1][a.x+1] == 'M')) &&
-		((input[a.y-1][a.x+1] == 'M' && input[a.y+1][a.x-1] == 'S') || (input[a.y-1][a.x+1] == 'S' && input[a.y+1][a.x-1] == 'M')) {
-		return true
-	}
+	upLeft, downRight := input.At(a.X-1, a.Y-1), input.At(a.X+1, a.Y+1)
+	upRight, downLeft := input.At(a.X+1, a.Y-1), input.At(a.X-1, a.Y+1)
 
-	return false
+	return ((upLeft == 'M' && downRight == 'S') || (upLeft == 'S' && downRight == 'M')) &&
+		((upRight == 'M' && downLeft == 'S') || (upRight == 'S' && downLeft == 'M'))
 }
@@ -1,7 +1,10 @@
 package exercise
 
 import (
+	"context"
 	"testing"
+
+	"github.com/trentnix/aoc2024/grid"
 )
 
 func TestDay4XmasCount(t *testing.T) {
@@ -18,17 +21,15 @@ func TestDay4XmasCount(t *testing.T) {
 		"MXMXAXMASX",
 	}
 
-	grid := make([][]rune, len(input))
-
-	for i, str := range input {
-		// Convert each string into a slice of runes
-		grid[i] = []rune(str)
+	letters, err := grid.Parse(input)
+	if err != nil {
+		t.Fatalf("Day 4 - parseInput Test:\nwant no error\ngot %v\n", err)
 	}
 
 	expectedValue := 18
 
 	d4 := Day4{}
-	calculatedValue := d4.Part1(grid)
+	calculatedValue := d4.Part1(letters)
 
 	if calculatedValue != expectedValue {
 		t.Errorf("Day 4 - Part 1 Test:\nwant %v\ngot %v\n", expectedValue, calculatedValue)
@@ -49,19 +50,39 @@ func TestDay4MasXCount(t *testing.T) {
 		"MXMXAXMASX",
 	}
 
-	grid := make([][]rune, len(input))
-
-	for i, str := range input {
-		// Convert each string into a slice of runes
-		grid[i] = []rune(str)
+	letters, err := grid.Parse(input)
+	if err != nil {
+		t.Fatalf("Day 4 - parseInput Test:\nwant no error\ngot %v\n", err)
 	}
 
 	expectedValue := 9
 
 	d4 := Day4{}
-	calculatedValue := d4.Part2(grid)
+	calculatedValue := d4.Part2(letters)
 
 	if calculatedValue != expectedValue {
 		t.Errorf("Day 4 - Part 2 Test:\nwant %v\ngot %v\n", expectedValue, calculatedValue)
 	}
 }
+
+func TestDay4GridTooSmall(t *testing.T) {
+	input := []string{
+		"XMA",
+		"MAS",
+		"AMX",
+	}
+
+	d4 := Day4{}
+
+	for _, part := range []int{1, 2} {
+		answer, err := d4.RunPart(context.Background(), input, part)
+		if err != nil || answer.Err == nil {
+			t.Errorf("Day 4 - Part %d (grid too small) Test:\nwant an answer with an error\ngot %v (%v)\n", part, answer.Err, err)
+		}
+	}
+
+	result, _ := d4.RunFromInput(context.Background(), input)
+	if result.Err() == nil {
+		t.Errorf("Day 4 - Result (grid too small) Test:\nwant an error\ngot %v\n", result.Err())
+	}
+}
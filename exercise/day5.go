@@ -2,9 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"strconv"
 	"strings"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
@@ -25,44 +24,60 @@ type (
 	pageNumbers []int
 )
 
+// init registers the Day 5 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 5, Title: "Print Queue", File: "data/2024/day5/input.txt"}, func(name, file string) Exercise {
+		return &Day5{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 5 exercise
 func (d *Day5) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 5 exercise
+func (d *Day5) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 5 by retrieving the default file contents and uses that data
-func (d *Day5) Run(w io.Writer) {
+func (d *Day5) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 5 solution using the provided input data
-func (d *Day5) RunFromInput(w io.Writer, input []string) {
+// RunFromInput executes the Day 5 solution using the provided input data and returns the answers
+func (d *Day5) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 5 solution using the provided input data and
+// returns the answer
+func (d *Day5) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	rules, pages, err := d.parseInput(input)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	sumOfMiddleValues := d.Part1(rules, pages)
-
-	// part 1
-	w.Write([]byte(fmt.Sprintf("Day 5 - Part 1 - The sum of the middle page numbers is %d.\n", sumOfMiddleValues)))
-
-	sumOfReorderedMiddleValues := d.Part2(rules, pages)
+	switch part {
+	case 1:
+		sumOfMiddleValues := d.Part1(rules, pages)
+		return Answer{Part: 1, Label: "The sum of the middle page numbers", Value: sumOfMiddleValues}, nil
+	case 2:
+		sumOfReorderedMiddleValues := d.Part2(rules, pages)
+		return Answer{Part: 2, Label: "The sum of the reordered middle page numbers", Value: sumOfReorderedMiddleValues}, nil
+	}
 
-	// part 2
-	w.Write([]byte(fmt.Sprintf("Day 5 - Part 2 - The sum of the reordered middle page numbers is %d.\n", sumOfReorderedMiddleValues)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 determines whether a list of pages is ordered correctly and, if so, it will
@@ -202,62 +217,38 @@ func (d *Day5) topologicalSort(nodes []int, edges map[int][]int) []int {
 // parseInput takes the input and parses it into a slice of orderingRule and a
 // slice of pageNumbers. If an error is encountered, an error is returned.
 func (d *Day5) parseInput(input []string) ([]orderingRule, []pageNumbers, error) {
-	var index int
-
-	var rules []orderingRule
-
-	// parse page ordering rules
-	for _, s := range input {
-		index++
-
-		if s == "" {
-			break
-		}
-
-		parts := strings.Split(s, "|")
-		if len(parts) != 2 {
-			return nil, nil, fmt.Errorf("error parsing input - line %d: %s", index-1, s)
-		}
-
-		before, err := strconv.Atoi(parts[0])
-		if err != nil {
-			return nil, nil, fmt.Errorf("error parsing input (converting parts[0]) - line %d: %s - %w", index-1, s, err)
-		}
+	// the page ordering rules are separated from the page lists by a blank line
+	sections, err := fileprocessing.Sections(input, 2)
+	if err != nil {
+		return nil, nil, fmt.Errorf("input malformed: %w", err)
+	}
 
-		after, err := strconv.Atoi(parts[1])
-		if err != nil {
-			return nil, nil, fmt.Errorf("error parsing input (converting parts[1]) - line %d: %s - %w", index-1, s, err)
+	for i, s := range sections[0].Lines {
+		if strings.Count(s, "|") != 1 {
+			return nil, nil, sections[0].LineErr(i, fmt.Errorf("expected an ordering rule of the form X|Y"))
 		}
-
-		rules = append(rules, orderingRule{before: before, after: after})
 	}
 
-	if index >= len(input) {
-		return nil, nil, fmt.Errorf("input malformed")
+	// parse page ordering rules
+	ruleValues, err := sections[0].Ints("|", 2)
+	if err != nil {
+		return nil, nil, err
 	}
 
-	var pages []pageNumbers
+	rules := make([]orderingRule, 0, len(ruleValues))
+	for _, values := range ruleValues {
+		rules = append(rules, orderingRule{before: values[0], after: values[1]})
+	}
 
 	// parse page numbers
-	for _, s := range input[index:] {
-		index++
-
-		numbers := strings.Split(s, ",")
-		var pageNum pageNumbers
-
-		for _, num := range numbers {
-			parsedNum, err := strconv.Atoi(num)
-			if err != nil {
-				return nil, nil, fmt.Errorf("error parsing input - line %d: %s - %w", index-1, s, err)
-			}
-			pageNum = append(pageNum, parsedNum)
-		}
-
-		if len(pageNum) == 0 {
-			return nil, nil, fmt.Errorf("error parsing input - pages list empty - line %d: %s", index-1, s)
-		}
+	pageValues, err := sections[1].Ints(",", 0)
+	if err != nil {
+		return nil, nil, err
+	}
 
-		pages = append(pages, pageNum)
+	pages := make([]pageNumbers, 0, len(pageValues))
+	for _, values := range pageValues {
+		pages = append(pages, pageNumbers(values))
 	}
 
 	return rules, pages, nil
@@ -1,7 +1,11 @@
 package exercise
 
 import (
+	"context"
+	"errors"
 	"testing"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
 )
 
 func TestDay5Part1(t *testing.T) {
@@ -97,3 +101,22 @@ func TestDay5Part2(t *testing.T) {
 		t.Errorf("Day 5 - Part 1 Test:\nwant %v\ngot %v\n", expectedValue, calculatedValue)
 	}
 }
+
+func TestDay5ParseError(t *testing.T) {
+	input := []string{
+		"47|53",
+		"97|13",
+		"",
+		"75,47,61,53,29",
+		"75 47 61 53 29",
+	}
+
+	d5 := Day5{}
+
+	_, err := d5.RunFromInput(context.Background(), input)
+
+	var parseErr *fileprocessing.ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 5 {
+		t.Errorf("Day 5 - Parse Error Test:\nwant an error on line %v\ngot %v\n", 5, err)
+	}
+}
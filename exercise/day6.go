@@ -2,10 +2,12 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -14,142 +16,119 @@ type (
 		name string
 		file string
 	}
-
-	Grid struct {
-		position [][]rune
-	}
-
-	Coordinate struct {
-		x int
-		y int
-	}
 )
 
+// init registers the Day 6 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 6, Title: "Guard Gallivant", File: "data/2024/day6/input.txt"}, func(name, file string) Exercise {
+		return &Day6{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 6 exercise
 func (d *Day6) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 6 exercise
+func (d *Day6) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 6 by retrieving the default file contents and uses that data
-func (d *Day6) Run(w io.Writer) {
+func (d *Day6) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executes the Day 6 solution using the provided input data
-func (d *Day6) RunFromInput(w io.Writer, input []string) {
-	g := d.parseInput(input)
-
-	// part 1
-	numberPositionsVisitedByGuard := d.Part1(g.Copy())
-	w.Write([]byte(fmt.Sprintf("Day 6 - Part 1 - The number of positions visited by the guard is %d.\n", numberPositionsVisitedByGuard)))
-
-	// part 2
-	numLoops := d.Part2(g.Copy())
-	w.Write([]byte(fmt.Sprintf("Day 6 - Part 2 - The number of new blocks that result in a loop is %d.\n", numLoops)))
+// RunFromInput executes the Day 6 solution using the provided input data and returns the answers
+func (d *Day6) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// Part1 moves the guard through the map (grid) and counts how many positions
-// the guard covers
-func (d *Day6) Part1(g *Grid) int {
-	guardPositionX, guardPositionY, direction := d.findGuardPositionAndDirection(g)
-	_, err := d.traverseGridLoop(g, guardPositionX, guardPositionY, direction)
+// RunPart executes the specified part of the Day 6 solution using the provided input data and
+// returns the answer
+func (d *Day6) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	g, err := d.parseInput(input)
 	if err != nil {
-		fmt.Printf("there was an error traversing the grid")
-		return -1
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	return d.countVisited(g)
-}
-
-// countVisited counts the number of positions whose value is 'X', indicating they
-// were visited by the guard
-func (d *Day6) countVisited(g *Grid) int {
-	numVisited := 0
-	for _, row := range g.position {
-		for _, c := range row {
-			if c == 'X' {
-				numVisited++
-			}
-		}
+	// parseInput has checked that the guard is on the grid, so the parts can't fail to find it
+	switch part {
+	case 1:
+		numberPositionsVisitedByGuard := d.Part1(g.Copy())
+		return Answer{Part: 1, Label: "The number of positions visited by the guard", Value: numberPositionsVisitedByGuard}, nil
+	case 2:
+		numLoops := d.Part2(g.Copy())
+		return Answer{Part: 2, Label: "The number of new blocks that result in a loop", Value: numLoops}, nil
 	}
 
-	return numVisited
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
-// isInvalidPosition determines whether the x,y is within the grid whose size is
-// specified by sizeX, sizeY
-func (d *Day6) isInvalidPosition(x, y, sizeX, sizeY int) bool {
-	if x >= sizeX || x < 0 {
-		return true
+// Part1 moves the guard through the map (grid) and counts how many positions
+// the guard covers. -1 is returned if the guard can't be found.
+func (d *Day6) Part1(g *grid.Grid[rune]) int {
+	guardPosition, direction, found := d.findGuardPositionAndDirection(g)
+	if !found {
+		return -1
 	}
 
-	if y >= sizeY || y < 0 {
-		return true
-	}
+	d.traverseGridLoop(g, guardPosition, direction)
 
-	return false
+	return d.countVisited(g)
 }
 
-// findGuardPosition returns the position of the guard assuming the upper-leftmost position
-// on the grid is 0, 0 and both x and y increase as you move down and right on the grid
-func (d *Day6) findGuardPositionAndDirection(g *Grid) (int, int, string) {
-	for y, row := range g.position {
-		for x, c := range row {
-			if c == '^' {
-				return x, y, "north"
-			}
-
-			if c == '>' {
-				return x, y, "east"
-			}
-
-			if c == '<' {
-				return x, y, "west"
-			}
+// countVisited counts the number of positions whose value is 'X', indicating they
+// were visited by the guard
+func (d *Day6) countVisited(g *grid.Grid[rune]) int {
+	return g.Count(grid.Equal('X'))
+}
 
-			if c == 'v' {
-				return x, y, "south"
-			}
-		}
+// findGuardPosition returns the position of the guard (and the direction they face)
+// assuming the upper-leftmost position on the grid is 0, 0 and both x and y increase as
+// you move down and right on the grid. false is returned if the guard can't be found.
+func (d *Day6) findGuardPositionAndDirection(g *grid.Grid[rune]) (geometry.Point, geometry.Direction, bool) {
+	guard, found := g.Find(func(c rune) bool {
+		_, isGuard := geometry.ParseDirection(c)
+		return isGuard
+	})
+	if !found {
+		return guard, geometry.North, false
 	}
 
-	return -1, -1, ""
+	direction, _ := geometry.ParseDirection(g.At(guard.X, guard.Y))
+	return guard, direction, true
 }
 
 // Part2 adds an obstruction to each point in the grid and looks for scenarios where
-// the guard loops due to the added obstruction
-func (d *Day6) Part2(g *Grid) int {
+// the guard loops due to the added obstruction. -1 is returned if the guard can't be
+// found.
+func (d *Day6) Part2(g *grid.Grid[rune]) int {
 	numObstructionsThatCauseLoops := 0
-	guardPositionX, guardPositionY, direction := d.findGuardPositionAndDirection(g)
-
-	for currentY, row := range g.position {
-		for currentX, c := range row {
-			if c != 'X' && c != '#' && !(currentX == guardPositionX && currentY == guardPositionY) {
-				newGrid := g.Copy()
+	guardPosition, direction, found := d.findGuardPositionAndDirection(g)
+	if !found {
+		return -1
+	}
 
-				// add a block to the current position
-				newGrid.position[currentY][currentX] = 'O'
+	for current, c := range g.All() {
+		if c != 'X' && c != '#' && current != guardPosition {
+			newGrid := g.Copy()
 
-				isLooped, err := d.traverseGridLoop(newGrid, guardPositionX, guardPositionY, direction)
-				if err != nil {
-					fmt.Printf("there was an error traversing the grid")
-					return -1
-				}
+			// add a block to the current position
+			newGrid.Set(current.X, current.Y, 'O')
 
-				if isLooped {
-					numObstructionsThatCauseLoops++
-				}
+			if d.traverseGridLoop(newGrid, guardPosition, direction) {
+				numObstructionsThatCauseLoops++
 			}
 		}
 	}
@@ -164,121 +143,67 @@ func (d *Day6) Part2(g *Grid) int {
 // Otherwise, take a step forward.
 //
 // It returns 'true' when a loop is detected.
-func (d *Day6) traverseGridLoop(g *Grid, startX int, startY int, direction string) (bool, error) {
-	sizeX := len(g.position[0])
-	sizeY := len(g.position)
-
+func (d *Day6) traverseGridLoop(g *grid.Grid[rune], start geometry.Point, direction geometry.Direction) bool {
 	// set the initial position as visited
-	g.position[startY][startX] = 'X'
+	g.Set(start.X, start.Y, 'X')
 
-	currentX, currentY := startX, startY
-	var nextX, nextY int
+	current := start
 
 	// create a slice to store coordinates of the grid to help detect for loops
-	var loopCoordinates []Coordinate
+	var loopCoordinates []geometry.Point
 
 	for {
-		nextX, nextY = currentX, currentY
-
 		// update the next position
-		switch direction {
-		case "north":
-			nextY--
-		case "east":
-			nextX++
-		case "south":
-			nextY++
-		case "west":
-			nextX--
-		default:
-			return false, fmt.Errorf("invalid direction value: %s", direction)
-		}
+		next := current.Move(direction)
 
-		if d.isInvalidPosition(nextX, nextY, sizeX, sizeY) {
-			g.position[currentY][currentX] = 'X'
+		nextValue, inBounds := g.Get(next.X, next.Y)
+		if !inBounds {
+			g.Set(current.X, current.Y, 'X')
 			break
 		}
 
 		// figure out if blocked
-		if g.position[nextY][nextX] == '#' || g.position[nextY][nextX] == 'O' {
+		if nextValue == '#' || nextValue == 'O' {
 			// need to turn
-			switch direction {
-			case "north":
-				direction = "east"
-			case "east":
-				direction = "south"
-			case "south":
-				direction = "west"
-			case "west":
-				direction = "north"
-			default:
-				return false, fmt.Errorf("invalid direction value: %s", direction)
-			}
+			direction = direction.TurnRight()
 
-			if g.position[nextY][nextX] == 'O' {
+			if nextValue == 'O' {
 				if len(loopCoordinates)%4 == 0 && len(loopCoordinates) > 0 {
-					if loopCoordinates[0].x == currentX && loopCoordinates[0].y == currentY {
+					if loopCoordinates[0] == current {
 						// we've made a loop back to the original element - report a looping event
-						return true, nil
+						return true
 					}
 				}
 
-				loopCoordinates = append(loopCoordinates, Coordinate{x: currentX, y: currentY})
+				loopCoordinates = append(loopCoordinates, current)
 			} else {
 				// reset the loopBlockCounter since we encountered a new block
 				loopCoordinates = nil
-				g.position[nextY][nextX] = 'O'
+				g.Set(next.X, next.Y, 'O')
 			}
 		} else {
 			// set the current position to visited and move the current position
-			if g.position[currentY][currentX] == '.' {
-				g.position[currentY][currentX] = 'X'
+			if g.At(current.X, current.Y) == '.' {
+				g.Set(current.X, current.Y, 'X')
 			}
-			currentX, currentY = nextX, nextY
+			current = next
 		}
 	}
 
-	return false, nil
-}
-
-// parseInput takes the string array input and converts it into a Grid
-func (d *Day6) parseInput(input []string) *Grid {
-	var grid Grid
-
-	// Convert each string in the input to a slice of runes
-	for _, line := range input {
-		row := []rune(line) // Convert string to rune slice
-		grid.position = append(grid.position, row)
-	}
-
-	return &grid
+	return false
 }
 
-// Copy() returns a deep copy of the source Grid
-func (g *Grid) Copy() *Grid {
-	copyGrid := &Grid{
-		position: make([][]rune, len(g.position)),
-	}
-
-	// Deep copy each row of the grid
-	for i, row := range g.position {
-		// Create a new slice for each row and copy the contents
-		copyGrid.position[i] = make([]rune, len(row))
-		copy(copyGrid.position[i], row)
+// parseInput takes the string array input and converts it into a grid. An error is
+// returned if the input isn't a grid or the guard can't be found.
+func (d *Day6) parseInput(input []string) (*grid.Grid[rune], error) {
+	g, err := grid.Parse(input)
+	if err != nil {
+		return nil, err
 	}
 
-	return copyGrid
-}
-
-// printGrid provides a pretty-print of the grid to stdout
-func (g *Grid) Print() {
-	fmt.Println("Grid:")
-	for _, row := range g.position {
-		for _, cell := range row {
-			fmt.Printf("%c ", cell) // Print each rune with a space
-		}
-		fmt.Println() // Newline after each row
+	if _, _, found := d.findGuardPositionAndDirection(g); !found {
+		return nil, fmt.Errorf("the guard (^, >, v, or <) could not be found")
 	}
 
-	fmt.Println()
+	return g, nil
 }
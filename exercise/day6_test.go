@@ -20,7 +20,12 @@ func TestDay6Part1(t *testing.T) {
 
 	d6 := Day6{}
 
-	g := d6.parseInput(input)
+	g, err := d6.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
 	calculatedValue := d6.Part1(g)
 	expectedValue := 41
 
@@ -45,7 +50,12 @@ func TestDay6Part2(t *testing.T) {
 
 	d6 := Day6{}
 
-	g := d6.parseInput(input)
+	g, err := d6.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
+
 	calculatedValue := d6.Part2(g)
 	expectedValue := 6
 
@@ -2,8 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 	"strconv"
 	"strings"
 
@@ -23,42 +23,60 @@ type (
 	}
 )
 
+// init registers the Day 7 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 7, Title: "Bridge Repair", File: "data/2024/day7/input.txt"}, func(name, file string) Exercise {
+		return &Day7{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 7 exercise
 func (d *Day7) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 7 exercise
+func (d *Day7) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 7 by retrieving the default file contents and uses that data
-func (d *Day7) Run(w io.Writer) {
+func (d *Day7) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 7 solution using the provided input data
-func (d *Day7) RunFromInput(w io.Writer, input []string) {
+// RunFromInput executes the Day 7 solution using the provided input data and returns the answers
+func (d *Day7) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
+
+// RunPart executes the specified part of the Day 7 solution using the provided input data and
+// returns the answer
+func (d *Day7) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	equations, err := d.parseInput(input)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-		return
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	}
 
-	// part 1
-	sumTrueEquations := d.Part1(equations)
-	w.Write([]byte(fmt.Sprintf("Day 7 - Part 1 - The sum of the true equations is %d.\n", sumTrueEquations)))
+	switch part {
+	case 1:
+		sumTrueEquations := d.Part1(equations)
+		return Answer{Part: 1, Label: "The sum of the true equations", Value: sumTrueEquations}, nil
+	case 2:
+		sumTrueEquations := d.Part2(equations)
+		return Answer{Part: 2, Label: "The sum of the true equations (with concatenation)", Value: sumTrueEquations}, nil
+	}
 
-	// part 2
-	sumTrueEquations = d.Part2(equations)
-	w.Write([]byte(fmt.Sprintf("Day 7 - Part 2 - The sum of the true equations is %d.\n", sumTrueEquations)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 calculates the sum of solvable equations using an operator set of "+" and "*", if
@@ -168,26 +186,30 @@ func (d *Day7) Part2(equations []Equation) uint64 {
 //	 would result in Equation{Value: 3267, Inputs: {81, 40, 27}}
 func (d *Day7) parseInput(input []string) ([]Equation, error) {
 	var equations []Equation
-	for _, line := range input {
+	for i, line := range input {
 		// Split the line into the value part and inputs part
 		parts := strings.Split(line, ":")
 		if len(parts) != 2 {
-			return nil, fmt.Errorf("invalid line format: %s", line)
+			return nil, fileprocessing.NewParseError(i+1, line, fmt.Errorf("expected an equation of the form value: inputs"))
 		}
 
 		// Parse the value (before the colon)
 		value, err := strconv.ParseInt(strings.TrimSpace(parts[0]), 10, 64)
 		if err != nil {
-			return nil, fmt.Errorf("invalid value format: %s", parts[0])
+			return nil, fileprocessing.NewParseError(i+1, line, err)
 		}
 
 		// Parse the inputs (after the colon)
 		inputStrings := strings.Fields(parts[1])
+		if len(inputStrings) == 0 {
+			return nil, fileprocessing.NewParseError(i+1, line, fmt.Errorf("the equation has no inputs"))
+		}
+
 		var inputs []int64
 		for _, inputStr := range inputStrings {
 			inputValue, err := strconv.ParseInt(inputStr, 10, 64)
 			if err != nil {
-				return nil, fmt.Errorf("invalid input format: %s", inputStr)
+				return nil, fileprocessing.NewParseError(i+1, line, err)
 			}
 			inputs = append(inputs, inputValue)
 		}
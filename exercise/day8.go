@@ -2,10 +2,12 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+	"github.com/trentnix/aoc2024/grid"
 )
 
 type (
@@ -14,54 +16,67 @@ type (
 		name string
 		file string
 	}
-
-	AntennaMap struct {
-		frequency [][]rune
-	}
-
-	AntennaMapCoordinate struct {
-		x int
-		y int
-	}
 )
 
+// init registers the Day 8 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 8, Title: "Resonant Collinearity", File: "data/2024/day8/input.txt"}, func(name, file string) Exercise {
+		return &Day8{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 8 exercise
 func (d *Day8) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 8 exercise
+func (d *Day8) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 8 by retrieving the default file contents and uses that data
-func (d *Day8) Run(w io.Writer) {
+func (d *Day8) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
 }
 
-// RunFromInput executs the Day 8 solution using the provided input data
-func (d *Day8) RunFromInput(w io.Writer, input []string) {
-	antennaMap := d.parseInput(input)
+// RunFromInput executes the Day 8 solution using the provided input data and returns the answers
+func (d *Day8) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
+}
 
-	// part 1
-	numberAntinodes := d.Part1(antennaMap)
-	w.Write([]byte(fmt.Sprintf("Day 8 - Part 1 - The number of antinodes in the map is %d.\n", numberAntinodes)))
+// RunPart executes the specified part of the Day 8 solution using the provided input data and
+// returns the answer
+func (d *Day8) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
+	antennaMap, err := d.parseInput(input)
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
+
+	switch part {
+	case 1:
+		numberAntinodes := d.Part1(antennaMap)
+		return Answer{Part: 1, Label: "The number of antinodes in the map", Value: numberAntinodes}, nil
+	case 2:
+		numberAntinodes := d.Part2(antennaMap)
+		return Answer{Part: 2, Label: "The number of repeating antinodes in the map", Value: numberAntinodes}, nil
+	}
 
-	// // part 2
-	numberAntinodes = d.Part2(antennaMap)
-	w.Write([]byte(fmt.Sprintf("Day 8 - Part 2 - The number of antinodes in the map is %d.\n", numberAntinodes)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 calculates antinode locations and counts the number of antinodes (the rules are specified in
 // the readme for the day)
-func (d *Day8) Part1(antennaMap *AntennaMap) int {
+func (d *Day8) Part1(antennaMap *grid.Grid[rune]) int {
 	antennaFrequencies := d.getUniqueFrequencies(antennaMap)
 
 	antinodeMap := antennaMap.Copy()
@@ -71,14 +86,14 @@ func (d *Day8) Part1(antennaMap *AntennaMap) int {
 		}
 	}
 
-	antinodeCount := antinodeMap.countOccurences('#')
+	antinodeCount := antinodeMap.Count(grid.Equal('#'))
 
 	return antinodeCount
 }
 
 // Part2 calculates antinode locations and counts the number of repeating antinodes (the rules are
 // specified in the readme for the day)
-func (d *Day8) Part2(antennaMap *AntennaMap) int {
+func (d *Day8) Part2(antennaMap *grid.Grid[rune]) int {
 	antennaFrequencies := d.getUniqueFrequencies(antennaMap)
 
 	antinodeMap := antennaMap.Copy()
@@ -88,34 +103,25 @@ func (d *Day8) Part2(antennaMap *AntennaMap) int {
 		}
 	}
 
-	antinodeCount := antinodeMap.countOccurences('#')
+	antinodeCount := antinodeMap.Count(grid.Equal('#'))
 
 	return antinodeCount
 }
 
-// parseData
-func (d *Day8) parseInput(input []string) *AntennaMap {
-	var antennaMap AntennaMap
-
-	// Convert each string in the input to a slice of runes
-	for _, line := range input {
-		row := []rune(line) // Convert string to rune slice
-		antennaMap.frequency = append(antennaMap.frequency, row)
-	}
-
-	return &antennaMap
+// parseInput converts the input into a grid of antenna frequencies. An error is returned if
+// the input isn't a grid.
+func (d *Day8) parseInput(input []string) (*grid.Grid[rune], error) {
+	return grid.Parse(input)
 }
 
-// getUniqueFrequencies takes an AntennaMap and returns a map object with a list of coordinates
+// getUniqueFrequencies takes an antenna map and returns a map object with a list of coordinates
 // where a specific frequency can be found
-func (d *Day8) getUniqueFrequencies(a *AntennaMap) map[rune][]AntennaMapCoordinate {
-	antennaFrequencies := make(map[rune][]AntennaMapCoordinate)
+func (d *Day8) getUniqueFrequencies(a *grid.Grid[rune]) map[rune][]geometry.Point {
+	antennaFrequencies := make(map[rune][]geometry.Point)
 
-	for y, row := range a.frequency {
-		for x, frequency := range row {
-			if frequency != '.' {
-				antennaFrequencies[frequency] = append(antennaFrequencies[frequency], AntennaMapCoordinate{x: x, y: y})
-			}
+	for position, frequency := range a.All() {
+		if frequency != '.' {
+			antennaFrequencies[frequency] = append(antennaFrequencies[frequency], position)
 		}
 	}
 
@@ -123,97 +129,48 @@ func (d *Day8) getUniqueFrequencies(a *AntennaMap) map[rune][]AntennaMapCoordina
 }
 
 // SetAntinodes processes the antennaPositions relative to the sourceAntenna and applies the marker value
-// to the specified AntennaMap for any discovered antinodes. The repeatingAntinodes parameter determines
+// to the specified antenna map for any discovered antinodes. The repeatingAntinodes parameter determines
 // whether only a single antinode exists when comparing a pair of antennas on the same frequency or
 // whether the antinodes repeat.
-func (d *Day8) SetAntinodes(a *AntennaMap, sourceAntenna AntennaMapCoordinate, antennaPositions []AntennaMapCoordinate, marker rune, repeatingAntinodes bool) {
-	if a == nil || len(a.frequency) == 0 || len(a.frequency[0]) == 0 {
+func (d *Day8) SetAntinodes(a *grid.Grid[rune], sourceAntenna geometry.Point, antennaPositions []geometry.Point, marker rune, repeatingAntinodes bool) {
+	if a == nil {
 		return
 	}
 
-	colSize := len(a.frequency)
-	rowSize := len(a.frequency[0])
-
 	for _, position := range antennaPositions {
-		if position.x == sourceAntenna.x && position.y == sourceAntenna.y {
+		if position == sourceAntenna {
 			// the source is the same as the destination
 			continue
 		}
 
-		deltaX := position.x - sourceAntenna.x
-		deltaY := position.y - sourceAntenna.y
+		delta := position.Sub(sourceAntenna)
 
 		if repeatingAntinodes {
 			for i := 1; true; i++ {
 				// set the position in line
-				antinodePositionX := deltaX*i + position.x
-				antinodePositionY := deltaY*i + position.y
+				antinode := position.Add(delta.Scale(i))
 
-				if antinodePositionX < 0 || antinodePositionY < 0 || antinodePositionX >= rowSize || antinodePositionY >= colSize {
+				if !a.InBounds(antinode.X, antinode.Y) {
 					// the position is not on the grid
 					break
 				}
 
-				a.frequency[antinodePositionY][antinodePositionX] = '#'
+				a.Set(antinode.X, antinode.Y, marker)
 			}
 		} else {
 			// set the position in line
-			antinodePositionX := deltaX + position.x
-			antinodePositionY := deltaY + position.y
+			antinode := position.Add(delta)
 
-			if antinodePositionX >= 0 && antinodePositionY >= 0 && antinodePositionX < rowSize && antinodePositionY < colSize {
+			if a.InBounds(antinode.X, antinode.Y) {
 				// the position is on the grid
-				a.frequency[antinodePositionY][antinodePositionX] = '#'
+				a.Set(antinode.X, antinode.Y, marker)
 			}
 		}
 
 		if repeatingAntinodes {
 			if len(antennaPositions) > 1 {
-				a.frequency[position.y][position.x] = '#'
+				a.Set(position.X, position.Y, marker)
 			}
 		}
 	}
 }
-
-// countOccurences navigates the entire AntennaMap to count the occurrences of the specified value
-func (a *AntennaMap) countOccurences(r rune) int {
-	numOccurences := 0
-	for _, row := range a.frequency {
-		for _, frequency := range row {
-			if r == frequency {
-				numOccurences++
-			}
-		}
-	}
-
-	return numOccurences
-}
-
-// Copy() returns a deep copy of the source AntennaMap
-func (a *AntennaMap) Copy() *AntennaMap {
-	copyAntennaMap := &AntennaMap{
-		frequency: make([][]rune, len(a.frequency)),
-	}
-
-	// Deep copy each row of the grid
-	for i, row := range a.frequency {
-		// Create a new slice for each row and copy the contents
-		copyAntennaMap.frequency[i] = make([]rune, len(row))
-		copy(copyAntennaMap.frequency[i], row)
-	}
-
-	return copyAntennaMap
-}
-
-// printGrid provides a pretty-print of the AntennaMap to stdout
-func (a *AntennaMap) Print() {
-	fmt.Println("Grid:")
-	for _, row := range a.frequency {
-		for _, cell := range row {
-			fmt.Printf("%c", cell) // Print each rune with a space
-		}
-		fmt.Println() // Newline after each row
-	}
-
-	fmt.Println()
-}
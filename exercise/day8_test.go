@@ -22,7 +22,11 @@ func TestDay8Part1(t *testing.T) {
 
 	d8 := Day8{}
 
-	antennaMap := d8.parseInput(input)
+	antennaMap, err := d8.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d8.Part1(antennaMap)
 	expectedValue := 14
@@ -50,7 +54,11 @@ func TestDay8Part2(t *testing.T) {
 
 	d8 := Day8{}
 
-	antennaMap := d8.parseInput(input)
+	antennaMap, err := d8.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d8.Part2(antennaMap)
 	expectedValue := 34
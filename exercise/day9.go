@@ -2,10 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
-	"log"
-	"strconv"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -32,41 +30,64 @@ type (
 	}
 )
 
+// init registers the Day 9 exercise
+func init() {
+	RegisterExercise(Info{Year: 2024, Day: 9, Title: "Disk Fragmenter", File: "data/2024/day9/input.txt"}, func(name, file string) Exercise {
+		return &Day9{name: name, file: file}
+	})
+}
+
 // GetName returns the name of the Day 9 exercise
 func (d *Day9) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day 9 exercise
+func (d *Day9) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day 9 by retrieving the default file contents and uses that data
-func (d *Day9) Run(w io.Writer) {
+func (d *Day9) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day 9 solution using the provided input data and returns the answers
+func (d *Day9) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// RunFromInput executs the Day 9 solution using the provided input data
-func (d *Day9) RunFromInput(w io.Writer, input []string) {
+// RunPart executes the specified part of the Day 9 solution using the provided input data and
+// returns the answer
+func (d *Day9) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	if len(input) != 1 {
-		log.Fatalf("the input was invalid")
-		return
+		return Answer{Part: part}, fmt.Errorf("the input was invalid: expected 1 line, got %d", len(input))
 	}
 
-	diskMap := d.parseInput(input[0])
+	diskMap, err := d.parseInput(input[0])
+	if err != nil {
+		return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
+	}
 
-	inputChecksum := d.Part1(diskMap)
-	w.Write([]byte(fmt.Sprintf("Day 9 - Part 1 - The checksum of the input is %d.\n", inputChecksum)))
+	switch part {
+	case 1:
+		inputChecksum := d.Part1(diskMap)
+		return Answer{Part: 1, Label: "The checksum of the compressed disk", Value: inputChecksum}, nil
+	case 2:
+		inputChecksum := d.Part2(diskMap)
+		return Answer{Part: 2, Label: "The checksum of the disk compressed by whole files", Value: inputChecksum}, nil
+	}
 
-	inputChecksum = d.Part2(diskMap)
-	w.Write([]byte(fmt.Sprintf("Day 9 - Part 2 - The checksum of the input is %d.\n", inputChecksum)))
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1 takes the specified DiskMap, creates a DiskData instance, compresses it, and
@@ -86,18 +107,26 @@ func (d *Day9) Part2(m DiskMap) int64 {
 	return diskData.CalculateChecksum()
 }
 
-// parseInput processes an input string into a DiskMap instance
-func (d *Day9) parseInput(input string) DiskMap {
+// parseInput processes an input string into a DiskMap instance. An error is returned if
+// the input contains anything other than digits.
+func (d *Day9) parseInput(input string) (DiskMap, error) {
 	var diskMap DiskMap
 
 	inputLength := len(input)
+	if inputLength == 0 {
+		return nil, fileprocessing.NewParseError(1, input, fmt.Errorf("the disk map is empty"))
+	}
+
+	for i, c := range input {
+		if c < '0' || c > '9' {
+			return nil, fileprocessing.NewParseError(1, input, fmt.Errorf("invalid character %q at position %d", c, i))
+		}
+	}
+
 	var i int
 	for i = 0; i+1 < inputLength; i = i + 2 {
-		cFileLength := input[i]
-		cSpaceLength := input[i+1]
-
-		iFileLength, _ := strconv.Atoi(string(cFileLength))
-		iSpaceLength, _ := strconv.Atoi(string(cSpaceLength))
+		iFileLength := int(input[i] - '0')
+		iSpaceLength := int(input[i+1] - '0')
 
 		block := DiskMapBlock{
 			Index:           i / 2,
@@ -108,18 +137,18 @@ func (d *Day9) parseInput(input string) DiskMap {
 		diskMap = append(diskMap, block)
 	}
 
-	cFileLength := input[i]
-	iFileLength, _ := strconv.Atoi(string(cFileLength))
-	// need to handle the last element, which has no free block
-	block := DiskMapBlock{
-		Index:           i / 2,
-		FileLength:      iFileLength,
-		FreeSpaceLength: 0,
-	}
+	if i < inputLength {
+		// need to handle the last element, which has no free block
+		block := DiskMapBlock{
+			Index:           i / 2,
+			FileLength:      int(input[i] - '0'),
+			FreeSpaceLength: 0,
+		}
 
-	diskMap = append(diskMap, block)
+		diskMap = append(diskMap, block)
+	}
 
-	return diskMap
+	return diskMap, nil
 }
 
 // NewDiskData creates a DiskData instance from the specified DiskMap
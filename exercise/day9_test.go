@@ -9,7 +9,11 @@ func TestDay9Part1(t *testing.T) {
 
 	d9 := Day9{}
 
-	diskMap := d9.parseInput(input)
+	diskMap, err := d9.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d9.Part1(diskMap)
 	expectedValue := int64(60)
@@ -20,7 +24,11 @@ func TestDay9Part1(t *testing.T) {
 
 	input2 := "2333133121414131402"
 
-	diskMap2 := d9.parseInput(input2)
+	diskMap2, err := d9.parseInput(input2)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue = d9.Part1(diskMap2)
 	expectedValue = int64(1928)
@@ -34,7 +42,11 @@ func TestDay9Part2(t *testing.T) {
 	input := "2333133121414131402"
 
 	d9 := Day9{}
-	diskMap := d9.parseInput(input)
+	diskMap, err := d9.parseInput(input)
+	if err != nil {
+		t.Errorf("There was an error parsing the input: %v", err)
+		return
+	}
 
 	calculatedValue := d9.Part2(diskMap)
 	expectedValue := int64(2858)
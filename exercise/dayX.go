@@ -2,8 +2,8 @@
 package exercise
 
 import (
+	"context"
 	"fmt"
-	"io"
 
 	"github.com/trentnix/aoc2024/fileprocessing"
 )
@@ -21,29 +21,46 @@ func (d *DayX) GetName() string {
 	return d.name
 }
 
+// GetFile returns the default input file of the Day X exercise
+func (d *DayX) GetFile() string {
+	return d.file
+}
+
 // Run executes the solution for Day X by retrieving the default file contents and uses that data
-func (d *DayX) Run(w io.Writer) {
+func (d *DayX) Run(ctx context.Context) (Result, error) {
 	if d.file == "" {
-		w.Write([]byte(fmt.Sprintf("A default input file is not specified.")))
-		return
+		return Result{Name: d.name}, fmt.Errorf("a default input file is not specified")
 	}
 
 	input, err := fileprocessing.ReadFile(d.file)
 	if err != nil {
-		w.Write([]byte(fmt.Sprintf("There was an error trying to read the input file %s: %v.", d.file, err)))
-		return
+		return Result{Name: d.name}, fmt.Errorf("there was an error trying to read the input file %s: %w", d.file, err)
 	}
 
-	d.RunFromInput(w, input)
+	return d.RunFromInput(ctx, input)
+}
+
+// RunFromInput executes the Day X solution using the provided input data and returns the answers
+func (d *DayX) RunFromInput(ctx context.Context, input []string) (Result, error) {
+	return RunParts(ctx, d, input, 1, 2)
 }
 
-// RunFromInput executs the Day X solution using the provided input data
-func (d *DayX) RunFromInput(w io.Writer, input []string) {
+// RunPart executes the specified part of the Day X solution using the provided input data and
+// returns the answer
+func (d *DayX) RunPart(ctx context.Context, input []string, part int) (Answer, error) {
 	// data, err := // parse the data
 	// if err != nil {
-	// 	w.Write([]byte(fmt.Sprintf("There was an error trying to parse the input: %v.", err)))
-	// 	return
+	// 	return Answer{Part: part}, fmt.Errorf("there was an error trying to parse the input: %w", err)
 	// }
+
+	switch part {
+	case 1:
+		return Answer{Part: 1, Label: "", Value: d.Part1()}, nil
+	case 2:
+		return Answer{Part: 2, Label: "", Value: d.Part2()}, nil
+	}
+
+	return Answer{Part: part}, fmt.Errorf("%w: %d", ErrInvalidPart, part)
 }
 
 // Part1
@@ -0,0 +1,85 @@
+package exercise
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/trentnix/aoc2024/puzzle"
+)
+
+// exampleAnswers are the answers the puzzle descriptions give for their examples. The
+// example inputs are read from the descriptions, so they don't need to be typed out here;
+// the input is example number example (starting at 0) of part examplePart.
+var exampleAnswers = []struct {
+	day         int
+	part        int
+	examplePart int
+	example     int
+	want        string
+}{
+	{day: 1, part: 1, examplePart: 1, example: 0, want: "11"},
+	{day: 1, part: 2, examplePart: 1, example: 0, want: "31"},
+	{day: 2, part: 1, examplePart: 1, example: 0, want: "2"},
+	{day: 2, part: 2, examplePart: 1, example: 0, want: "4"},
+	{day: 3, part: 1, examplePart: 1, example: 0, want: "161"},
+	{day: 3, part: 2, examplePart: 2, example: 0, want: "48"},
+	{day: 4, part: 1, examplePart: 1, example: 0, want: "18"},
+	{day: 4, part: 2, examplePart: 1, example: 0, want: "9"},
+	{day: 5, part: 1, examplePart: 1, example: 0, want: "143"},
+	{day: 5, part: 2, examplePart: 1, example: 0, want: "123"},
+	{day: 6, part: 1, examplePart: 1, example: 0, want: "41"},
+	{day: 6, part: 2, examplePart: 1, example: 0, want: "6"},
+	{day: 7, part: 1, examplePart: 1, example: 0, want: "3749"},
+	{day: 7, part: 2, examplePart: 1, example: 0, want: "11387"},
+	{day: 8, part: 1, examplePart: 1, example: 0, want: "14"},
+	{day: 8, part: 2, examplePart: 1, example: 0, want: "34"},
+	{day: 9, part: 1, examplePart: 1, example: 0, want: "1928"},
+	{day: 9, part: 2, examplePart: 1, example: 0, want: "2858"},
+	{day: 10, part: 1, examplePart: 1, example: 1, want: "36"},
+	{day: 10, part: 2, examplePart: 1, example: 1, want: "81"},
+	{day: 12, part: 1, examplePart: 1, example: 0, want: "140"},
+	{day: 12, part: 2, examplePart: 1, example: 0, want: "80"},
+	{day: 13, part: 1, examplePart: 1, example: 0, want: "480"},
+	{day: 15, part: 1, examplePart: 1, example: 0, want: "10092"},
+	{day: 15, part: 2, examplePart: 1, example: 0, want: "9021"},
+	{day: 16, part: 1, examplePart: 1, example: 0, want: "7036"},
+	{day: 16, part: 2, examplePart: 1, example: 0, want: "45"},
+	{day: 17, part: 1, examplePart: 1, example: 0, want: "4,6,3,5,6,3,5,2,1,0"},
+	{day: 17, part: 2, examplePart: 2, example: 0, want: "117440"},
+	{day: 19, part: 1, examplePart: 1, example: 0, want: "6"},
+	{day: 19, part: 2, examplePart: 1, example: 0, want: "16"},
+	{day: 21, part: 1, examplePart: 1, example: 0, want: "126384"},
+	{day: 22, part: 1, examplePart: 1, example: 0, want: "37327623"},
+	{day: 23, part: 1, examplePart: 1, example: 0, want: "7"},
+	{day: 23, part: 2, examplePart: 1, example: 0, want: "co,de,ka,ta"},
+	{day: 24, part: 1, examplePart: 1, example: 0, want: "4"},
+	{day: 24, part: 1, examplePart: 1, example: 1, want: "2024"},
+	{day: 25, part: 1, examplePart: 1, example: 0, want: "3"},
+}
+
+func TestExamples(t *testing.T) {
+	for _, test := range exampleAnswers {
+		registration, found := Lookup(2024, test.day)
+		if !found {
+			t.Fatalf("Day %d - Example Test: the day isn't registered", test.day)
+		}
+
+		// the tests run in the exercise directory, one level below the data directory
+		p, err := puzzle.Load(puzzle.Filename(filepath.Join("..", "data"), registration.Year, registration.Day))
+		if err != nil {
+			t.Fatalf("Day %d - Example Test: %v", test.day, err)
+		}
+
+		part, found := p.Part(test.examplePart)
+		if !found || test.example >= len(part.Examples) {
+			t.Errorf("Day %d - Part %d Example Test:\nwant example %d of part %d\ngot %d examples\n", test.day, test.part, test.example, test.examplePart, len(part.Examples))
+			continue
+		}
+
+		answer, err := registration.Exercise.RunPart(context.Background(), part.Examples[test.example].Lines, test.part)
+		if err != nil || answer.Err != nil || answer.String() != test.want {
+			t.Errorf("Day %d - Part %d Example Test:\nwant %v\ngot %v (%v, %v)\n", test.day, test.part, test.want, answer, err, answer.Err)
+		}
+	}
+}
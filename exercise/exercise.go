@@ -1,202 +1,172 @@
-// exercise.go defines the Exercise interface and initializes the exercises array
+// exercise.go defines the Exercise interface and the registry of exercises
 package exercise
 
-import "io"
-
-// the exercises array contains the implementations of each Advent of Code day's exercise
-var exercises []Exercise
-
-// init initializes the exercises array
-func init() {
-	day1 := &Day1{
-		name: "2024: Day 1",
-		file: "data/day1/input.txt",
-	}
-
-	RegisterExercise(day1)
-
-	day2 := &Day2{
-		name: "2024: Day 2",
-		file: "data/day2/input.txt",
-	}
-
-	RegisterExercise(day2)
-
-	day3 := &Day3{
-		name: "2024: Day 3",
-		file: "data/day3/input.txt",
-	}
-
-	RegisterExercise(day3)
-
-	day4 := &Day4{
-		name: "2024: Day 4",
-		file: "data/day4/input.txt",
-	}
-
-	RegisterExercise(day4)
-
-	day5 := &Day5{
-		name: "2024: Day 5",
-		file: "data/day5/input.txt",
-	}
-
-	RegisterExercise(day5)
-
-	day6 := &Day6{
-		name: "2024: Day 6",
-		file: "data/day6/input.txt",
-	}
-
-	RegisterExercise(day6)
-
-	day7 := &Day7{
-		name: "2024: Day 7",
-		file: "data/day7/input.txt",
-	}
-
-	RegisterExercise(day7)
-
-	day8 := &Day8{
-		name: "2024: Day 8",
-		file: "data/day8/input.txt",
-	}
-
-	RegisterExercise(day8)
-
-	day9 := &Day9{
-		name: "2024: Day 9",
-		file: "data/day9/input.txt",
-	}
-
-	RegisterExercise(day9)
-
-	day10 := &Day10{
-		name: "2024: Day 10",
-		file: "data/day10/input.txt",
-	}
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Key identifies an exercise by the year and day of its puzzle
+type Key struct {
+	Year int
+	Day  int
+}
 
-	RegisterExercise(day10)
+// Info describes a registered exercise
+type Info struct {
+	Year  int
+	Day   int
+	Title string // the title of the puzzle
+	File  string // the default input file
+}
 
-	day11 := &Day11{
-		name: "2024: Day 11",
-		file: "data/day11/input.txt",
-	}
+// Registration is an exercise and the information it was registered with
+type Registration struct {
+	Info
+	Exercise Exercise
+}
 
-	RegisterExercise(day11)
+// the registry contains the implementation of each Advent of Code day's exercise
+var registry = make(map[Key]Registration)
 
-	day12 := &Day12{
-		name: "2024: Day 12",
-		file: "data/day12/input.txt",
-	}
+// Key returns the key the exercise is registered under
+func (i Info) Key() Key {
+	return Key{Year: i.Year, Day: i.Day}
+}
 
-	RegisterExercise(day12)
+// Name returns the name of the exercise, such as "2024: Day 1"
+func (i Info) Name() string {
+	return fmt.Sprintf("%d: Day %d", i.Year, i.Day)
+}
 
-	day13 := &Day13{
-		name: "2024: Day 13",
-		file: "data/day13/input.txt",
+// RegisterExercise provides a way for an Exercise to register itself, typically from the
+// init function of the file that implements it. newExercise is called with the name and
+// default input file of the exercise and returns the exercise. RegisterExercise panics if
+// an exercise is already registered for the same year and day.
+func RegisterExercise(info Info, newExercise func(name, file string) Exercise) {
+	if info.Year <= 0 || info.Day <= 0 {
+		panic(fmt.Sprintf("exercise: invalid year %d and day %d", info.Year, info.Day))
 	}
 
-	RegisterExercise(day13)
-
-	day14 := &Day14{
-		name: "2024: Day 14",
-		file: "data/day14/input.txt",
+	if _, exists := registry[info.Key()]; exists {
+		panic(fmt.Sprintf("exercise: %s is registered twice", info.Name()))
 	}
 
-	RegisterExercise(day14)
-
-	day15 := &Day15{
-		name: "2024: Day 15",
-		file: "data/day15/input.txt",
-	}
+	registry[info.Key()] = Registration{Info: info, Exercise: newExercise(info.Name(), info.File)}
+}
 
-	RegisterExercise(day15)
+// Lookup returns the exercise registered for the specified year and day
+func Lookup(year, day int) (Registration, bool) {
+	registration, found := registry[Key{Year: year, Day: day}]
+	return registration, found
+}
 
-	day16 := &Day16{
-		name: "2024: Day 16",
-		file: "data/day16/input.txt",
+// Registrations returns the registered exercises ordered by year and day. The slice is a
+// copy, so callers (which may be running exercises concurrently) can't modify the registry.
+func Registrations() []Registration {
+	registrations := make([]Registration, 0, len(registry))
+	for _, registration := range registry {
+		registrations = append(registrations, registration)
 	}
 
-	RegisterExercise(day16)
+	slices.SortFunc(registrations, func(a, b Registration) int {
+		return cmp.Or(cmp.Compare(a.Year, b.Year), cmp.Compare(a.Day, b.Day))
+	})
 
-	day17 := &Day17{
-		name: "2024: Day 17",
-		file: "data/day17/input.txt",
-	}
-
-	RegisterExercise(day17)
+	return registrations
+}
 
-	day18 := &Day18{
-		name: "2024: Day 18",
-		file: "data/day18/input.txt",
+// GetExercises returns the registered exercises ordered by year and day
+func GetExercises() []Exercise {
+	var exercises []Exercise
+	for _, registration := range Registrations() {
+		exercises = append(exercises, registration.Exercise)
 	}
 
-	RegisterExercise(day18)
-
-	day19 := &Day19{
-		name: "2024: Day 19",
-		file: "data/day19/input.txt",
-	}
+	return exercises
+}
 
-	RegisterExercise(day19)
+// defines the Exercise interface. The context is checked by the solutions that can run for
+// a long time (or forever on unexpected input), so a run can be cancelled or timed out.
+type Exercise interface {
+	GetName() string
+	GetFile() string
+	RunPart(ctx context.Context, input []string, part int) (Answer, error)
+	RunFromInput(ctx context.Context, input []string) (Result, error)
+	Run(ctx context.Context) (Result, error)
+}
 
-	day20 := &Day20{
-		name: "2024: Day 20",
-		file: "data/day20/input.txt",
-	}
+// contextCheckInterval is how many iterations a tight loop runs between checks of whether
+// its context is done, since checking the context is slow compared to a single iteration
+const contextCheckInterval = 1024
 
-	RegisterExercise(day20)
+// ErrInvalidPart is returned when an exercise is asked to run a part that it doesn't have
+var ErrInvalidPart = errors.New("the exercise does not have the specified part")
 
-	day21 := &Day21{
-		name: "2024: Day 21",
-		file: "data/day21/input.txt",
-	}
+// RunParts executes the specified parts of an exercise, in the order specified, using the
+// provided input data and returns the answers. Each part parses the input on its own, so a
+// part can be run without running the parts before it. The remaining parts aren't run if
+// the context is done. The duration of each part is recorded in its answer.
+func RunParts(ctx context.Context, ex Exercise, input []string, parts ...int) (Result, error) {
+	result := Result{Name: ex.GetName()}
 
-	RegisterExercise(day21)
+	for _, part := range parts {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
 
-	day22 := &Day22{
-		name: "2024: Day 22",
-		file: "data/day22/input.txt",
-	}
+		start := time.Now()
+		answer, err := ex.RunPart(ctx, input, part)
+		if err != nil {
+			return result, err
+		}
 
-	RegisterExercise(day22)
+		answer.Duration = time.Since(start)
 
-	day23 := &Day23{
-		name: "2024: Day 23",
-		file: "data/day23/input.txt",
+		result.Answers = append(result.Answers, answer)
 	}
 
-	RegisterExercise(day23)
+	return result, nil
+}
 
-	day24 := &Day24{
-		name: "2024: Day 24",
-		file: "data/day24/input.txt",
-	}
+// Answer is the answer to a single part of an exercise
+type Answer struct {
+	Part     int           // the part of the exercise (1 or 2)
+	Label    string        // a description of what the value represents
+	Value    any           // the answer itself
+	Err      error         // set if the input is valid but the part has no solution for it (e.g. a grid too small to search)
+	Duration time.Duration // how long the part took to run, set by RunParts
+}
 
-	RegisterExercise(day24)
+// Result contains the answers produced by running an exercise
+type Result struct {
+	Name    string
+	Answers []Answer
+}
 
-	day25 := &Day25{
-		name: "2024: Day 25",
-		file: "data/day25/input.txt",
+// String returns the answer value formatted as text, which is the form the Advent of
+// Code site expects when the answer is submitted
+func (a Answer) String() string {
+	if a.Value == nil {
+		return ""
 	}
 
-	RegisterExercise(day25)
+	return fmt.Sprint(a.Value)
 }
 
-// RegisterExercise provides a way for an Exercise to register itself
-func RegisterExercise(e Exercise) {
-	exercises = append(exercises, e)
-}
-
-// GetExercises returns the exercises slice
-func GetExercises() []Exercise {
-	return exercises
-}
+// Err returns the errors of any answers that could not be solved joined together, or nil
+// if every answer was solved
+func (r Result) Err() error {
+	var errs []error
+	for _, answer := range r.Answers {
+		if answer.Err != nil {
+			errs = append(errs, fmt.Errorf("part %d: %w", answer.Part, answer.Err))
+		}
+	}
 
-// defines the Exercise interface
-type Exercise interface {
-	GetName() string
-	RunFromInput(w io.Writer, input []string)
-	Run(w io.Writer)
+	return errors.Join(errs...)
 }
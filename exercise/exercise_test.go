@@ -0,0 +1,142 @@
+package exercise
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+// TestRunPartsConcurrently runs the same days from several goroutines at once and makes
+// sure each run produces the same answers as a run on its own. Run it with -race to detect
+// shared state between runs (like the maze code used by several days).
+func TestRunPartsConcurrently(t *testing.T) {
+	inputs := map[Exercise][]string{
+		&Day1{name: "Day 1"}: {
+			"3   4",
+			"4   3",
+			"2   5",
+			"1   3",
+			"3   9",
+			"3   3",
+		},
+		&Day16{name: "Day 16"}: {
+			"###############",
+			"#.......#....E#",
+			"#.#.###.#.###.#",
+			"#.....#.#...#.#",
+			"#.###.#####.#.#",
+			"#.#.#.......#.#",
+			"#.#.#####.###.#",
+			"#...........#.#",
+			"###.#.#####.#.#",
+			"#...#.....#.#.#",
+			"#.#.#.###.#.#.#",
+			"#.....#...#.#.#",
+			"#.###.#.#.#.#.#",
+			"#S..#.....#...#",
+			"###############",
+		},
+		&Day20{name: "Day 20"}: {
+			"###############",
+			"#...#...#.....#",
+			"#.#.#.#.#.###.#",
+			"#S#...#.#.#...#",
+			"#######.#.#.###",
+			"#######.#.#...#",
+			"#######.#.###.#",
+			"###..E#...#...#",
+			"###.#######.###",
+			"#...###...#...#",
+			"#.#####.#.###.#",
+			"#.#...#.#.#...#",
+			"#.#.#.#.#.#.###",
+			"#...#...#...###",
+			"###############",
+		},
+	}
+
+	for ex, input := range inputs {
+		expected, err := ex.RunFromInput(context.Background(), input)
+		if err != nil {
+			t.Fatalf("%s - Concurrent Test: unexpected error %v", ex.GetName(), err)
+		}
+
+		var wg sync.WaitGroup
+		results := make([]Result, 4)
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = ex.RunFromInput(context.Background(), input)
+			}()
+		}
+		wg.Wait()
+
+		for _, result := range append(results, expected) {
+			// the durations of the runs differ, so they aren't compared
+			for i := range result.Answers {
+				result.Answers[i].Duration = 0
+			}
+		}
+
+		for _, result := range results {
+			if !reflect.DeepEqual(result, expected) {
+				t.Errorf("%s - Concurrent Test:\nwant %v\ngot %v\n", ex.GetName(), expected, result)
+			}
+		}
+	}
+}
+
+func TestRegistrations(t *testing.T) {
+	registrations := Registrations()
+	if len(registrations) == 0 {
+		t.Fatalf("Registrations Test:\nwant at least one registration\ngot none\n")
+	}
+
+	for i, registration := range registrations {
+		if registration.Title == "" {
+			t.Errorf("Registrations Test (%s):\nwant a title\ngot none\n", registration.Name())
+		}
+
+		if i > 0 && !registrationLess(registrations[i-1].Info, registration.Info) {
+			t.Errorf("Registrations Test (order):\nwant %s after %s\ngot it before\n", registration.Name(), registrations[i-1].Name())
+		}
+
+		if want := fmt.Sprintf("data/%d/day%d/input.txt", registration.Year, registration.Day); registration.File != want {
+			t.Errorf("Registrations Test (%s file):\nwant %v\ngot %v\n", registration.Name(), want, registration.File)
+		}
+
+		if registration.Exercise.GetName() != registration.Name() || registration.Exercise.GetFile() != registration.File {
+			t.Errorf("Registrations Test (%s):\nwant %v, %v\ngot %v, %v\n", registration.Name(), registration.Name(), registration.File, registration.Exercise.GetName(), registration.Exercise.GetFile())
+		}
+
+		if found, ok := Lookup(registration.Year, registration.Day); !ok || found.Info != registration.Info || found.Exercise != registration.Exercise {
+			t.Errorf("Lookup Test (%s):\nwant %v\ngot %v (%v)\n", registration.Name(), registration.Info, found.Info, ok)
+		}
+	}
+
+	if registration, found := Lookup(2024, 23); !found || registration.Title != "LAN Party" {
+		t.Errorf("Lookup Test:\nwant %v\ngot %v (%v)\n", "LAN Party", registration.Title, found)
+	}
+
+	if _, found := Lookup(2023, 23); found {
+		t.Errorf("Lookup Test (2023):\nwant %v\ngot %v\n", false, found)
+	}
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("RegisterExercise Test (duplicate):\nwant a panic\ngot %v\n", r)
+		}
+	}()
+
+	RegisterExercise(Info{Year: 2024, Day: 1}, func(name, file string) Exercise {
+		return &Day1{name: name, file: file}
+	})
+}
+
+// registrationLess returns true if a is for an earlier year and day than b
+func registrationLess(a, b Info) bool {
+	return a.Year < b.Year || (a.Year == b.Year && a.Day < b.Day)
+}
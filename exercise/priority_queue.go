@@ -2,11 +2,15 @@
 // solution
 package exercise
 
+import (
+	"github.com/trentnix/aoc2024/geometry"
+)
+
 // Priority Queue Item
 type State struct {
 	node      *MazeNode
 	prev      *State
-	direction int
+	direction geometry.Direction
 	cost      int
 	index     int // For heap management
 }
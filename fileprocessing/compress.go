@@ -0,0 +1,82 @@
+// compress.go detects compressed inputs so they can be read without the exercises knowing
+package fileprocessing
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// the magic numbers that the compressed formats start with
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// GzipExtension is the extension ReadFile looks for when an input file doesn't exist, so a
+// compressed copy (e.g. input.txt.gz) can stand in for it
+const GzipExtension = ".gz"
+
+// ErrZstd is returned for input compressed with zstd. The standard library can't decompress
+// zstd and the module doesn't take on dependencies outside it, so the input has to be
+// decompressed first (e.g. with zstd -d) or recompressed with gzip.
+var ErrZstd = errors.New("zstd-compressed input isn't supported, since decompressing it needs a package outside the standard library: decompress it with zstd -d or recompress it with gzip")
+
+// Decompress returns a reader of the decompressed content of r if r is compressed with gzip,
+// or a reader of r itself if it isn't compressed. The format is detected from the content,
+// not the filename. ErrZstd is returned for zstd-compressed content. Closing the returned
+// reader doesn't close r.
+func Decompress(r io.Reader) (io.ReadCloser, error) {
+	buffered := bufio.NewReader(r)
+
+	// a short input can't be compressed, so io.EOF is ignored
+	magic, err := buffered.Peek(len(zstdMagic))
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+
+	switch {
+	case bytes.HasPrefix(magic, gzipMagic):
+		decompressed, err := gzip.NewReader(buffered)
+		if err != nil {
+			return nil, fmt.Errorf("error decompressing gzip input: %w", err)
+		}
+
+		return decompressed, nil
+	case bytes.HasPrefix(magic, zstdMagic):
+		return nil, ErrZstd
+	}
+
+	return io.NopCloser(buffered), nil
+}
+
+// readCompressed returns the lines of r (see Read), decompressing r if it is compressed
+func readCompressed(r io.Reader) ([]string, error) {
+	decompressed, err := Decompress(r)
+	if err != nil {
+		return nil, err
+	}
+
+	defer decompressed.Close()
+
+	// a corrupt gzip input is reported by the reads, once its checksum is reached
+	return Read(decompressed)
+}
+
+// compressedCopy returns the name of the gzip-compressed copy of filename if filename
+// doesn't exist but the copy does, otherwise filename itself
+func compressedCopy(filename string) string {
+	if _, err := os.Stat(filename); !errors.Is(err, os.ErrNotExist) {
+		return filename
+	}
+
+	if _, err := os.Stat(filename + GzipExtension); err == nil {
+		return filename + GzipExtension
+	}
+
+	return filename
+}
@@ -0,0 +1,95 @@
+package fileprocessing
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// gzipped returns the text compressed with gzip
+func gzipped(t *testing.T, text string) []byte {
+	var buffer bytes.Buffer
+
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(text)); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	return buffer.Bytes()
+}
+
+func TestReadFileCompressed(t *testing.T) {
+	dir := t.TempDir()
+	want := []string{"1", "10", "100", "2024"}
+
+	filename := filepath.Join(dir, "input.gz")
+	if err := os.WriteFile(filename, gzipped(t, "1\n10\n100\n2024\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err := ReadFile(filename)
+	if err != nil || !slices.Equal(lines, want) {
+		t.Errorf("ReadFile (gzip) Test:\nwant %v\ngot %v (%v)\n", want, lines, err)
+	}
+
+	// input.txt is read from input.txt.gz if it doesn't exist
+	if err := os.Rename(filename, filepath.Join(dir, "input.txt"+GzipExtension)); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err = ReadFile(filepath.Join(dir, "input.txt"))
+	if err != nil || !slices.Equal(lines, want) {
+		t.Errorf("ReadFile (gzip copy) Test:\nwant %v\ngot %v (%v)\n", want, lines, err)
+	}
+}
+
+func TestDecompress(t *testing.T) {
+	tests := []struct {
+		name  string
+		input []byte
+		want  []string
+	}{
+		{"plain", []byte("1\n2\n"), []string{"1", "2"}},
+		{"short", []byte("1"), []string{"1"}},
+		{"empty", nil, nil},
+		{"gzip", gzipped(t, "1\n2\n"), []string{"1", "2"}},
+	}
+
+	for _, test := range tests {
+		reader, err := Decompress(bytes.NewReader(test.input))
+		if err != nil {
+			t.Errorf("Decompress (%s) Test: unexpected error %v", test.name, err)
+			continue
+		}
+
+		lines, err := Read(reader)
+		if err != nil || !slices.Equal(lines, test.want) {
+			t.Errorf("Decompress (%s) Test:\nwant %v\ngot %v (%v)\n", test.name, test.want, lines, err)
+		}
+	}
+
+	if _, err := Decompress(bytes.NewReader([]byte{0x28, 0xb5, 0x2f, 0xfd, 0, 0})); !errors.Is(err, ErrZstd) {
+		t.Errorf("Decompress (zstd) Test:\nwant %v\ngot %v\n", ErrZstd, err)
+	}
+
+	// a corrupt checksum is reported once the input has been read
+	corrupt := gzipped(t, "1\n2\n")
+	corrupt[len(corrupt)-5] ^= 0xff
+
+	if _, err := readCompressed(bytes.NewReader(corrupt)); !errors.Is(err, gzip.ErrChecksum) {
+		t.Errorf("Decompress (corrupt) Test:\nwant %v\ngot %v\n", gzip.ErrChecksum, err)
+	}
+
+	if _, err := readCompressed(strings.NewReader("\x1f\x8bnot gzip")); err == nil {
+		t.Errorf("Decompress (invalid) Test:\nwant an error\ngot %v\n", err)
+	}
+}
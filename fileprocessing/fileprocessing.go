@@ -2,27 +2,204 @@
 package fileprocessing
 
 import (
-	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
+	"slices"
+	"strings"
 )
 
-// Readfile returns the contents of the specified filename if no error is encountered
-func ReadFile(filename string) ([]string, error) {
-	file, err := os.Open(filename)
+// ParseError reports a line of the input that could not be parsed
+type ParseError struct {
+	Line    int    // the line number (starting at 1) of the input that failed to parse
+	Content string // the content of the line that failed to parse
+	Err     error  // the reason the line failed to parse
+}
+
+// NewParseError returns a ParseError for the specified line number (starting at 1) and the
+// content of that line
+func NewParseError(line int, content string, err error) error {
+	return &ParseError{Line: line, Content: content, Err: err}
+}
+
+// Error returns the line number, content, and reason for the ParseError
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("line %d (%q): %v", e.Line, e.Content, e.Err)
+}
+
+// Unwrap returns the reason the line failed to parse
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateGrid checks that the specified lines form a character grid: at least one line
+// and every line the same length as the first. A ParseError is returned for the first
+// line that doesn't fit.
+func ValidateGrid(lines []string) error {
+	if len(lines) == 0 || len(lines[0]) == 0 {
+		return errors.New("the grid is empty")
+	}
+
+	width := len(lines[0])
+	for i, line := range lines {
+		if len(line) != width {
+			return NewParseError(i+1, line, fmt.Errorf("expected a row of length %d, got %d", width, len(line)))
+		}
+	}
+
+	return nil
+}
+
+// Stdin is the filename that ReadFile treats as standard input
+const Stdin = "-"
+
+// Readfile returns the contents of the specified filename if no error is encountered. If
+// the filename is Stdin ("-"), the contents of standard input are returned instead. The
+// file is opened with Open, so an embedded copy is used if the file isn't on disk. Input
+// compressed with gzip is decompressed (see Decompress), and if the file doesn't exist but
+// a copy compressed with gzip (filename.gz) does, the copy is read instead.
+func ReadFile(filename string) (lines []string, err error) {
+	if filename == Stdin {
+		lines, err := readCompressed(os.Stdin)
+		if err != nil {
+			return nil, fmt.Errorf("error reading stdin: %w", err)
+		}
+
+		return lines, nil
+	}
+
+	filename = compressedCopy(filename)
+
+	file, err := Open(filename)
 	if err != nil {
 		return nil, err
 	}
 
 	defer func() {
-		if err := file.Close(); err != nil {
-			panic(err)
+		if closeErr := file.Close(); closeErr != nil && err == nil {
+			lines, err = nil, closeErr
 		}
 	}()
 
+	lines, err = readCompressed(file)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", filename, err)
+	}
+
+	return lines, nil
+}
+
+// embedded is the file system Open falls back to for files that don't exist on disk
+var embedded fs.FS
+
+// SetEmbedded sets the file system that Open falls back to when a file doesn't exist on
+// disk, such as the data directory compiled into the binary. It must be called before any
+// files are opened (e.g. from an init function).
+func SetEmbedded(fsys fs.FS) {
+	embedded = fsys
+}
+
+// Open opens the specified file for reading. If the file doesn't exist on disk and the
+// relative filename exists in the file system set by SetEmbedded, that copy is opened
+// instead.
+func Open(filename string) (fs.File, error) {
+	file, err := os.Open(filename)
+	if err == nil {
+		return file, nil
+	}
+
+	if embedded == nil || !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+
+	name := filepath.ToSlash(filepath.Clean(filename))
+	if !fs.ValidPath(name) {
+		return nil, err
+	}
+
+	embeddedFile, embeddedErr := embedded.Open(name)
+	if embeddedErr != nil {
+		// report that the file doesn't exist on disk, which is where it was expected
+		return nil, err
+	}
+
+	return embeddedFile, nil
+}
+
+// FS returns the file system of the working directory, layered over the file system set by
+// SetEmbedded in the same way as Open: a file is opened from disk if it exists there, and
+// the entries of a directory are those on disk along with those that are only embedded.
+// It lets the data directory be walked (e.g. to search the puzzle descriptions) whether
+// it is on disk or compiled into the binary.
+func FS() fs.FS {
+	return layeredFS{}
+}
+
+// layeredFS is the file system returned by FS
+type layeredFS struct{}
+
+// Open opens the named file with Open
+func (layeredFS) Open(name string) (fs.File, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrInvalid}
+	}
+
+	return Open(filepath.FromSlash(name))
+}
+
+// ReadDir returns the entries of the named directory on disk and in the embedded file
+// system, sorted by name. An entry on disk replaces an embedded entry with the same name.
+func (layeredFS) ReadDir(name string) ([]fs.DirEntry, error) {
+	if !fs.ValidPath(name) {
+		return nil, &fs.PathError{Op: "readdir", Path: name, Err: fs.ErrInvalid}
+	}
+
+	entries, err := os.ReadDir(filepath.FromSlash(name))
+	if embedded == nil || (err != nil && !errors.Is(err, fs.ErrNotExist)) {
+		return entries, err
+	}
+
+	embeddedEntries, embeddedErr := fs.ReadDir(embedded, name)
+	if embeddedErr != nil {
+		// report the entries on disk, or that the directory doesn't exist on disk
+		return entries, err
+	}
+
+	onDisk := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		onDisk[entry.Name()] = true
+	}
+
+	for _, entry := range embeddedEntries {
+		if !onDisk[entry.Name()] {
+			entries = append(entries, entry)
+		}
+	}
+
+	slices.SortFunc(entries, func(a, b fs.DirEntry) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+
+	return entries, nil
+}
+
+// Read returns the lines read from r until the end of the input, without their line
+// endings. It allows input to come from anywhere, such as a pipe or a strings.Reader in a
+// test. Lines up to DefaultMaxLineSize bytes long are read; use a LineReader to stream the
+// lines instead, or to read longer ones.
+func Read(r io.Reader) ([]string, error) {
 	var lines []string
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		lines = append(lines, scanner.Text())
+
+	reader := NewLineReader(r, 0)
+	for line := range reader.Lines() {
+		lines = append(lines, line)
+	}
+
+	if err := reader.Err(); err != nil {
+		return nil, err
 	}
 
 	return lines, nil
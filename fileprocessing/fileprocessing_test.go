@@ -0,0 +1,115 @@
+package fileprocessing
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"testing/iotest"
+)
+
+func TestValidateGrid(t *testing.T) {
+	if err := ValidateGrid([]string{"ab", "cd"}); err != nil {
+		t.Errorf("ValidateGrid Test:\nwant %v\ngot %v\n", nil, err)
+	}
+
+	if err := ValidateGrid(nil); err == nil {
+		t.Errorf("ValidateGrid (empty) Test:\nwant an error\ngot %v\n", err)
+	}
+
+	err := ValidateGrid([]string{"ab", "cd", "e"})
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 3 || parseErr.Content != "e" {
+		t.Errorf("ValidateGrid (ragged) Test:\nwant a ParseError on line 3\ngot %v\n", err)
+	}
+}
+
+func TestReadFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(filename, []byte("1\n2\n3\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	lines, err := ReadFile(filename)
+	if err != nil || len(lines) != 3 || lines[2] != "3" {
+		t.Errorf("ReadFile Test:\nwant [1 2 3]\ngot %v (%v)\n", lines, err)
+	}
+
+	if _, err := ReadFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("ReadFile (missing) Test:\nwant an error\ngot %v\n", err)
+	}
+}
+
+func TestRead(t *testing.T) {
+	lines, err := Read(strings.NewReader("1\r\n\n3"))
+	if err != nil || len(lines) != 3 || lines[0] != "1" || lines[1] != "" || lines[2] != "3" {
+		t.Errorf("Read Test:\nwant [1  3]\ngot %q (%v)\n", lines, err)
+	}
+
+	lines, err = Read(strings.NewReader(""))
+	if err != nil || len(lines) != 0 {
+		t.Errorf("Read (empty) Test:\nwant []\ngot %q (%v)\n", lines, err)
+	}
+
+	if _, err := Read(iotest.ErrReader(errors.New("broken pipe"))); err == nil {
+		t.Errorf("Read (error) Test:\nwant an error\ngot %v\n", err)
+	}
+}
+
+func TestReadFileEmbedded(t *testing.T) {
+	SetEmbedded(fstest.MapFS{
+		"data/2024/day1/input.txt": {Data: []byte("embedded\n")},
+	})
+	defer SetEmbedded(nil)
+
+	lines, err := ReadFile(filepath.Join("data", "2024", "day1", "input.txt"))
+	if err != nil || len(lines) != 1 || lines[0] != "embedded" {
+		t.Errorf("ReadFile (embedded) Test:\nwant [embedded]\ngot %v (%v)\n", lines, err)
+	}
+
+	_, err = ReadFile(filepath.Join("data", "2024", "day2", "input.txt"))
+	if !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("ReadFile (missing) Test:\nwant %v\ngot %v\n", os.ErrNotExist, err)
+	}
+}
+
+func TestFS(t *testing.T) {
+	SetEmbedded(fstest.MapFS{
+		"data/2024/day1/day1.md": {Data: []byte("embedded\n")},
+		"data/2024/day2/day2.md": {Data: []byte("embedded\n")},
+	})
+	defer SetEmbedded(nil)
+
+	var found []string
+	err := fs.WalkDir(FS(), "data", func(name string, entry fs.DirEntry, err error) error {
+		if err == nil && !entry.IsDir() {
+			found = append(found, name)
+		}
+
+		return err
+	})
+
+	if want := []string{"data/2024/day1/day1.md", "data/2024/day2/day2.md"}; err != nil || !slices.Equal(found, want) {
+		t.Errorf("FS (embedded) Test:\nwant %v\ngot %v (%v)\n", want, found, err)
+	}
+
+	// the working directory (the package directory while testing) is layered over the
+	// embedded files
+	entries, err := fs.ReadDir(FS(), ".")
+	names := make([]string, len(entries))
+	for i, entry := range entries {
+		names[i] = entry.Name()
+	}
+
+	if err != nil || !slices.Contains(names, "data") || !slices.Contains(names, "fileprocessing.go") {
+		t.Errorf("FS (layered) Test:\nwant data and fileprocessing.go\ngot %v (%v)\n", names, err)
+	}
+
+	if _, err := fs.ReadFile(FS(), "data/2024/day3/day3.md"); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("FS (missing) Test:\nwant %v\ngot %v\n", fs.ErrNotExist, err)
+	}
+}
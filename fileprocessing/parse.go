@@ -0,0 +1,289 @@
+// parse.go provides the parsing shared by the exercises: the integers of a line, blocks of
+// lines separated by blank lines, "key: value" lines and character grids. The errors are
+// ParseErrors, so they report the line number of the input that failed to parse.
+package fileprocessing
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Block is a run of lines of the input that isn't interrupted by a blank line
+type Block struct {
+	Line  int // the line number (starting at 1) of the first line of the block in the input
+	Lines []string
+}
+
+// Ints returns the integers of a line made up of integers separated by sep, such as "3   4"
+// (with sep "", which separates them by whitespace), "47|53" (with sep "|") or "75,47,61"
+// (with sep ","). The spaces around each integer are ignored. An error is returned if
+// anything else is in the line, so malformed input isn't mistaken for integers.
+func Ints(line, sep string) ([]int, error) {
+	var fields []string
+	if sep == "" {
+		fields = strings.Fields(line)
+	} else {
+		fields = strings.Split(line, sep)
+	}
+
+	values := make([]int, 0, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+
+		digits := strings.TrimPrefix(field, "-")
+		if digits == "" || strings.TrimLeft(digits, "0123456789") != "" {
+			return nil, fmt.Errorf("%q is not an integer", field)
+		}
+
+		value, err := strconv.Atoi(field)
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// ParseInts returns the integers of each of the lines, separated by sep (see Ints). If count
+// is greater than 0, every line must contain exactly that many integers; otherwise every line
+// must contain at least one. A ParseError is returned for the first line that doesn't, or
+// that contains anything other than integers and separators.
+func ParseInts(lines []string, sep string, count int) ([][]int, error) {
+	return parseLines(lines, count, func(line string) ([]int, error) {
+		return Ints(line, sep)
+	})
+}
+
+// ExtractInts returns every integer in the free text of a line, in the order they appear.
+// Anything that isn't a digit separates the integers, and a '-' directly ahead of a digit
+// makes the integer negative, so "p=0,4 v=3,-3" returns [0 4 3 -3] and
+// "Button A: X+94, Y+34" returns [94 34]. Nothing in the line is rejected, so the caller
+// has to check the format of the line (see Ints for a line of integers only).
+func ExtractInts(line string) ([]int, error) {
+	var values []int
+
+	for i := 0; i < len(line); i++ {
+		if !isDigit(line[i]) {
+			continue
+		}
+
+		start := i
+		if start > 0 && line[start-1] == '-' {
+			start--
+		}
+
+		for i < len(line) && isDigit(line[i]) {
+			i++
+		}
+
+		value, err := strconv.Atoi(line[start:i])
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// ExtractAllInts returns the integers in the free text of each of the lines (see
+// ExtractInts). If count is greater than 0, every line must contain exactly that many
+// integers; otherwise every line must contain at least one. A ParseError is returned for the
+// first line that doesn't.
+func ExtractAllInts(lines []string, count int) ([][]int, error) {
+	return parseLines(lines, count, ExtractInts)
+}
+
+// parseLines returns the integers returned by ints for each of the lines, checking that each
+// line has count of them (or at least one, if count is 0)
+func parseLines(lines []string, count int, ints func(line string) ([]int, error)) ([][]int, error) {
+	values := make([][]int, 0, len(lines))
+
+	for i, line := range lines {
+		lineValues, err := ints(line)
+		if err != nil {
+			return nil, NewParseError(i+1, line, err)
+		}
+
+		if count > 0 && len(lineValues) != count {
+			return nil, NewParseError(i+1, line, fmt.Errorf("expected %d integers, got %d", count, len(lineValues)))
+		}
+
+		if len(lineValues) == 0 {
+			return nil, NewParseError(i+1, line, errors.New("expected at least one integer"))
+		}
+
+		values = append(values, lineValues)
+	}
+
+	return values, nil
+}
+
+// Blocks splits the lines into the blocks separated by one or more blank lines. Blank lines
+// at the start and end of the input are ignored.
+func Blocks(lines []string) []Block {
+	var blocks []Block
+
+	start := -1
+	for i := 0; i <= len(lines); i++ {
+		if i < len(lines) && strings.TrimSpace(lines[i]) != "" {
+			if start < 0 {
+				start = i
+			}
+
+			continue
+		}
+
+		if start >= 0 {
+			blocks = append(blocks, Block{Line: start + 1, Lines: lines[start:i]})
+			start = -1
+		}
+	}
+
+	return blocks
+}
+
+// Sections splits the lines into exactly count blocks (see Blocks), such as the rules and
+// the updates of an input that separates them with a blank line. An error is returned if
+// there is a different number of blocks.
+func Sections(lines []string, count int) ([]Block, error) {
+	blocks := Blocks(lines)
+	if len(blocks) != count {
+		return nil, fmt.Errorf("expected %d sections separated by blank lines, got %d", count, len(blocks))
+	}
+
+	return blocks, nil
+}
+
+// Err returns err with the line numbers of any ParseError in it changed from the line
+// numbers of the block to those of the input the block is in
+func (b Block) Err(err error) error {
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		offset := *parseErr
+		offset.Line += b.Line - 1
+		return &offset
+	}
+
+	return err
+}
+
+// LineErr returns a ParseError for the line with the specified index in the block
+func (b Block) LineErr(index int, err error) error {
+	return NewParseError(b.Line+index, b.Lines[index], err)
+}
+
+// Ints returns the integers, separated by sep, of each line of the block (see ParseInts)
+func (b Block) Ints(sep string, count int) ([][]int, error) {
+	values, err := ParseInts(b.Lines, sep, count)
+	return values, b.Err(err)
+}
+
+// ExtractInts returns the integers in the free text of each line of the block (see
+// ExtractAllInts)
+func (b Block) ExtractInts(count int) ([][]int, error) {
+	values, err := ExtractAllInts(b.Lines, count)
+	return values, b.Err(err)
+}
+
+// KeyValues returns the "key: value" lines of the block (see ParseKeyValues), with the line
+// numbers of the input the block is in
+func (b Block) KeyValues() ([]KeyValue, error) {
+	values, err := ParseKeyValues(b.Lines)
+	for i := range values {
+		values[i].Line += b.Line - 1
+	}
+
+	return values, b.Err(err)
+}
+
+// Grid returns the block as a character grid (see ParseGrid)
+func (b Block) Grid() ([][]rune, error) {
+	grid, err := ParseGrid(b.Lines)
+	return grid, b.Err(err)
+}
+
+// KeyValue is a line of the form "key: value"
+type KeyValue struct {
+	Line  int    // the line number (starting at 1) of the line in the input
+	Text  string // the content of the line
+	Key   string // the text ahead of the first ':', without the surrounding spaces
+	Value string // the text after the first ':', without the surrounding spaces
+}
+
+// Err returns a ParseError for the line of the KeyValue
+func (kv KeyValue) Err(err error) error {
+	return NewParseError(kv.Line, kv.Text, err)
+}
+
+// Int returns the value as an integer. A ParseError is returned if it isn't one.
+func (kv KeyValue) Int() (int, error) {
+	value, err := strconv.Atoi(kv.Value)
+	if err != nil {
+		return 0, kv.Err(err)
+	}
+
+	return value, nil
+}
+
+// ParseKeyValues returns the "key: value" lines in the order they appear. Blank lines are
+// skipped. A ParseError is returned for a line that doesn't have a ':' or has an empty key.
+func ParseKeyValues(lines []string) ([]KeyValue, error) {
+	var values []KeyValue
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		if key = strings.TrimSpace(key); !found || key == "" {
+			return nil, NewParseError(i+1, line, errors.New("expected a line of the form key: value"))
+		}
+
+		values = append(values, KeyValue{Line: i + 1, Text: line, Key: key, Value: strings.TrimSpace(value)})
+	}
+
+	return values, nil
+}
+
+// ParseGrid returns the lines as a character grid of rows, after checking them with
+// ValidateGrid
+func ParseGrid(lines []string) ([][]rune, error) {
+	return ParseGridFunc(lines, func(r rune) (rune, error) { return r, nil })
+}
+
+// ParseGridFunc returns the lines as a grid of the values returned by parse for each
+// character, after checking them with ValidateGrid. A ParseError is returned for the first
+// line with a character that parse returns an error for.
+func ParseGridFunc[T any](lines []string, parse func(r rune) (T, error)) ([][]T, error) {
+	if err := ValidateGrid(lines); err != nil {
+		return nil, err
+	}
+
+	grid := make([][]T, len(lines))
+	for y, line := range lines {
+		grid[y] = make([]T, 0, len(line))
+
+		for x, r := range line {
+			value, err := parse(r)
+			if err != nil {
+				return nil, NewParseError(y+1, line, fmt.Errorf("column %d: %w", x+1, err))
+			}
+
+			grid[y] = append(grid[y], value)
+		}
+	}
+
+	return grid, nil
+}
+
+// isDigit returns true if c is an ASCII digit
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,200 @@
+package fileprocessing
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestInts(t *testing.T) {
+	tests := []struct {
+		line string
+		sep  string
+		want []int
+	}{
+		{"3   4", "", []int{3, 4}},
+		{" 7 6 4 2 1 ", "", []int{7, 6, 4, 2, 1}},
+		{"47|53", "|", []int{47, 53}},
+		{"75,47, 61", ",", []int{75, 47, 61}},
+		{"-5 10", "", []int{-5, 10}},
+		{"", "", []int{}},
+	}
+
+	for _, test := range tests {
+		got, err := Ints(test.line, test.sep)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("Ints Test: %q\nwant %v\ngot %v (%v)\n", test.line, test.want, got, err)
+		}
+	}
+
+	// anything other than integers and separators is rejected
+	for _, test := range []struct{ line, sep string }{
+		{"3 x 4", ""}, {"3abc 4", ""}, {"3-4", ""}, {"+3 4", ""}, {"7,6,4,2,1", ""},
+		{"75 47 61", ","}, {"75,,61", ","}, {"47|53|", "|"}, {"- 1", ""},
+	} {
+		if _, err := Ints(test.line, test.sep); err == nil {
+			t.Errorf("Ints (malformed) Test: %q\nwant an error\ngot %v\n", test.line, err)
+		}
+	}
+
+	if _, err := Ints("99999999999999999999", ""); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Ints (overflow) Test:\nwant %v\ngot %v\n", strconv.ErrRange, err)
+	}
+}
+
+func TestExtractInts(t *testing.T) {
+	tests := []struct {
+		line string
+		want []int
+	}{
+		{"p=0,4 v=3,-3", []int{0, 4, 3, -3}},
+		{"Button A: X+94, Y+34", []int{94, 34}},
+		{"Register A: 729", []int{729}},
+		{"3   4", []int{3, 4}},
+		{"47|53", []int{47, 53}},
+		{"x00: 1", []int{0, 1}},
+		{"--5 a-b", []int{-5}},
+		{"no numbers", nil},
+	}
+
+	for _, test := range tests {
+		got, err := ExtractInts(test.line)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("ExtractInts Test: %q\nwant %v\ngot %v (%v)\n", test.line, test.want, got, err)
+		}
+	}
+
+	if _, err := ExtractInts("99999999999999999999"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("ExtractInts (overflow) Test:\nwant %v\ngot %v\n", strconv.ErrRange, err)
+	}
+
+	got, err := ExtractAllInts([]string{"p=0,4 v=3,-3", "p=6,3 v=-1,-3"}, 4)
+	if want := [][]int{{0, 4, 3, -3}, {6, 3, -1, -3}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ExtractAllInts Test:\nwant %v\ngot %v (%v)\n", want, got, err)
+	}
+}
+
+func TestParseInts(t *testing.T) {
+	got, err := ParseInts([]string{"3   4", "4   3"}, "", 2)
+	if want := [][]int{{3, 4}, {4, 3}}; err != nil || !reflect.DeepEqual(got, want) {
+		t.Errorf("ParseInts Test:\nwant %v\ngot %v (%v)\n", want, got, err)
+	}
+
+	tests := []struct {
+		lines []string
+		sep   string
+		count int
+		line  int
+	}{
+		{[]string{"3   4", "4   3   2"}, "", 2, 2},
+		{[]string{"1 2 3", "", "4"}, "", 0, 2},
+		{[]string{"3   4", "3-4"}, "", 2, 2},
+		{[]string{"75,47", "75 47"}, ",", 0, 2},
+	}
+
+	for _, test := range tests {
+		_, err := ParseInts(test.lines, test.sep, test.count)
+		var parseErr *ParseError
+		if !errors.As(err, &parseErr) || parseErr.Line != test.line {
+			t.Errorf("ParseInts (error) Test: %q\nwant a ParseError on line %d\ngot %v\n", test.lines, test.line, err)
+		}
+	}
+}
+
+func TestBlocks(t *testing.T) {
+	lines := []string{"", "a", "b", "", "", "c", " ", "d", ""}
+
+	want := []Block{{Line: 2, Lines: []string{"a", "b"}}, {Line: 6, Lines: []string{"c"}}, {Line: 8, Lines: []string{"d"}}}
+	if got := Blocks(lines); !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocks Test:\nwant %v\ngot %v\n", want, got)
+	}
+
+	if blocks := Blocks(nil); len(blocks) != 0 {
+		t.Errorf("Blocks (empty) Test:\nwant %v\ngot %v\n", 0, len(blocks))
+	}
+
+	if _, err := Sections(lines, 3); err != nil {
+		t.Errorf("Sections Test:\nwant %v\ngot %v\n", nil, err)
+	}
+
+	if _, err := Sections(lines, 2); err == nil {
+		t.Errorf("Sections (count) Test:\nwant an error\ngot %v\n", err)
+	}
+}
+
+func TestBlockErrors(t *testing.T) {
+	blocks := Blocks([]string{"1,2", "", "3,4", "5", "", "ab", "c"})
+
+	// the line numbers are those of the input, not of the block
+	_, err := blocks[1].Ints(",", 2)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 4 || parseErr.Content != "5" {
+		t.Errorf("Block Ints Test:\nwant a ParseError on line 4\ngot %v\n", err)
+	}
+
+	_, err = blocks[2].Grid()
+	if !errors.As(err, &parseErr) || parseErr.Line != 7 || parseErr.Content != "c" {
+		t.Errorf("Block Grid Test:\nwant a ParseError on line 7\ngot %v\n", err)
+	}
+
+	err = blocks[1].LineErr(1, errors.New("bad"))
+	if !errors.As(err, &parseErr) || parseErr.Line != 4 || parseErr.Content != "5" {
+		t.Errorf("Block LineErr Test:\nwant a ParseError on line 4\ngot %v\n", err)
+	}
+}
+
+func TestParseKeyValues(t *testing.T) {
+	blocks := Blocks([]string{"Register A: 729", "Register B: x", "", "Program: 0,1,5,4"})
+
+	values, err := blocks[0].KeyValues()
+	if err != nil || len(values) != 2 || values[1].Key != "Register B" || values[1].Value != "x" || values[1].Line != 2 {
+		t.Fatalf("ParseKeyValues Test:\nwant [Register A: 729, Register B: x]\ngot %v (%v)\n", values, err)
+	}
+
+	if value, err := values[0].Int(); err != nil || value != 729 {
+		t.Errorf("KeyValue Int Test:\nwant %v\ngot %v (%v)\n", 729, value, err)
+	}
+
+	_, err = values[1].Int()
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+		t.Errorf("KeyValue Int (error) Test:\nwant a ParseError on line 2\ngot %v\n", err)
+	}
+
+	values, err = blocks[1].KeyValues()
+	if err != nil || len(values) != 1 || values[0].Line != 4 || values[0].Value != "0,1,5,4" {
+		t.Errorf("ParseKeyValues (block) Test:\nwant [Program: 0,1,5,4] on line 4\ngot %v (%v)\n", values, err)
+	}
+
+	_, err = ParseKeyValues([]string{"a: 1", "", "b 2"})
+	if !errors.As(err, &parseErr) || parseErr.Line != 3 {
+		t.Errorf("ParseKeyValues (error) Test:\nwant a ParseError on line 3\ngot %v\n", err)
+	}
+}
+
+func TestParseGrid(t *testing.T) {
+	grid, err := ParseGrid([]string{"#.", ".@"})
+	if want := [][]rune{{'#', '.'}, {'.', '@'}}; err != nil || !reflect.DeepEqual(grid, want) {
+		t.Errorf("ParseGrid Test:\nwant %v\ngot %v (%v)\n", want, grid, err)
+	}
+
+	digit := func(r rune) (int, error) {
+		if r < '0' || r > '9' {
+			return 0, errors.New("not a digit")
+		}
+
+		return int(r - '0'), nil
+	}
+
+	heights, err := ParseGridFunc([]string{"01", "23"}, digit)
+	if want := [][]int{{0, 1}, {2, 3}}; err != nil || !reflect.DeepEqual(heights, want) {
+		t.Errorf("ParseGridFunc Test:\nwant %v\ngot %v (%v)\n", want, heights, err)
+	}
+
+	_, err = ParseGridFunc([]string{"01", "2x"}, digit)
+	var parseErr *ParseError
+	if !errors.As(err, &parseErr) || parseErr.Line != 2 {
+		t.Errorf("ParseGridFunc (error) Test:\nwant a ParseError on line 2\ngot %v\n", err)
+	}
+}
@@ -0,0 +1,70 @@
+// stream.go reads an input a line (or token) at a time, so large inputs don't have to be
+// held in memory and lines longer than bufio.Scanner's default limit can be read
+package fileprocessing
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+)
+
+// DefaultMaxLineSize is the longest line (in bytes) a LineReader reads if a maximum isn't
+// specified. It is far larger than bufio.MaxScanTokenSize (64 KiB), since some inputs are a
+// single very long line; the buffer only grows as large as the longest line.
+const DefaultMaxLineSize = 16 << 20
+
+// LineReader streams the lines (or other tokens) read from an io.Reader through an
+// iterator. The input can only be read once. Any error that stops the iteration early,
+// including a line that is longer than the maximum, is returned by Err.
+type LineReader struct {
+	r           io.Reader
+	maxLineSize int
+	count       int // the number of tokens that have been read
+	err         error
+}
+
+// NewLineReader returns a LineReader that reads from r. Lines longer than maxLineSize bytes
+// are reported as errors; DefaultMaxLineSize is used if maxLineSize is 0 or less.
+func NewLineReader(r io.Reader, maxLineSize int) *LineReader {
+	if maxLineSize <= 0 {
+		maxLineSize = DefaultMaxLineSize
+	}
+
+	return &LineReader{r: r, maxLineSize: maxLineSize}
+}
+
+// Lines returns an iterator over the lines of the input, without their line endings
+func (lr *LineReader) Lines() iter.Seq[string] {
+	return lr.Tokens(bufio.ScanLines)
+}
+
+// Tokens returns an iterator over the tokens of the input, as split by split (such as
+// bufio.ScanWords or bufio.ScanRunes)
+func (lr *LineReader) Tokens(split bufio.SplitFunc) iter.Seq[string] {
+	return func(yield func(string) bool) {
+		scanner := bufio.NewScanner(lr.r)
+		scanner.Buffer(make([]byte, 0, min(bufio.MaxScanTokenSize, lr.maxLineSize)), lr.maxLineSize)
+		scanner.Split(split)
+
+		for scanner.Scan() {
+			lr.count++
+			if !yield(scanner.Text()) {
+				return
+			}
+		}
+
+		if err := scanner.Err(); errors.Is(err, bufio.ErrTooLong) {
+			lr.err = fmt.Errorf("line %d is longer than the maximum of %d bytes: %w", lr.count+1, lr.maxLineSize, err)
+		} else if err != nil {
+			lr.err = fmt.Errorf("error after line %d: %w", lr.count, err)
+		}
+	}
+}
+
+// Err returns the error that stopped the iteration, or nil if the whole input was read (or
+// the iteration was stopped by the caller)
+func (lr *LineReader) Err() error {
+	return lr.err
+}
@@ -0,0 +1,75 @@
+package fileprocessing
+
+import (
+	"bufio"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLineReader(t *testing.T) {
+	reader := NewLineReader(strings.NewReader("1\r\n\n3"), 0)
+
+	lines := slices.Collect(reader.Lines())
+	if want := []string{"1", "", "3"}; !slices.Equal(lines, want) || reader.Err() != nil {
+		t.Errorf("LineReader Test:\nwant %q\ngot %q (%v)\n", want, lines, reader.Err())
+	}
+
+	reader = NewLineReader(strings.NewReader("p=0,4 v=3,-3\np=6,3 v=-1,-3"), 0)
+
+	words := slices.Collect(reader.Tokens(bufio.ScanWords))
+	if want := []string{"p=0,4", "v=3,-3", "p=6,3", "v=-1,-3"}; !slices.Equal(words, want) || reader.Err() != nil {
+		t.Errorf("LineReader (words) Test:\nwant %q\ngot %q (%v)\n", want, words, reader.Err())
+	}
+}
+
+func TestLineReaderLongLines(t *testing.T) {
+	// longer than the 64 KiB a bufio.Scanner reads by default
+	long := strings.Repeat("2333133121414131402", 10000)
+
+	lines, err := Read(strings.NewReader("first\n" + long + "\n"))
+	if err != nil || len(lines) != 2 || lines[1] != long {
+		t.Errorf("Read (long line) Test:\nwant 2 lines, the second of %d bytes\ngot %d lines (%v)\n", len(long), len(lines), err)
+	}
+
+	reader := NewLineReader(strings.NewReader("first\n"+long+"\nlast\n"), 1024)
+
+	lines = slices.Collect(reader.Lines())
+	if len(lines) != 1 || !errors.Is(reader.Err(), bufio.ErrTooLong) || !strings.Contains(reader.Err().Error(), "line 2") {
+		t.Errorf("LineReader (maximum) Test:\nwant 1 line and an error for line 2\ngot %d lines (%v)\n", len(lines), reader.Err())
+	}
+}
+
+func TestLineReaderErrors(t *testing.T) {
+	broken := errors.New("broken pipe")
+	reader := NewLineReader(iotest.TimeoutReader(strings.NewReader("1\n2\n")), 0)
+
+	for range reader.Lines() {
+	}
+
+	if !errors.Is(reader.Err(), iotest.ErrTimeout) {
+		t.Errorf("LineReader (error) Test:\nwant %v\ngot %v\n", iotest.ErrTimeout, reader.Err())
+	}
+
+	reader = NewLineReader(iotest.ErrReader(broken), 0)
+	for range reader.Lines() {
+	}
+
+	if !errors.Is(reader.Err(), broken) {
+		t.Errorf("LineReader (error) Test:\nwant %v\ngot %v\n", broken, reader.Err())
+	}
+
+	// stopping early isn't an error
+	reader = NewLineReader(strings.NewReader("1\n2\n3\n"), 0)
+	for line := range reader.Lines() {
+		if line == "2" {
+			break
+		}
+	}
+
+	if reader.Err() != nil {
+		t.Errorf("LineReader (break) Test:\nwant %v\ngot %v\n", nil, reader.Err())
+	}
+}
@@ -0,0 +1,134 @@
+// geometry.go provides the points and directions the exercises use to move around a grid
+package geometry
+
+import (
+	"fmt"
+)
+
+// Point is a position (or the offset between two positions) on a grid, in columns (X) and
+// rows (Y). Y increases downwards, as the rows of an input do, so North is a step of -1 in Y.
+type Point struct {
+	X, Y int
+}
+
+// Add returns the sum of p and q
+func (p Point) Add(q Point) Point {
+	return Point{X: p.X + q.X, Y: p.Y + q.Y}
+}
+
+// Sub returns the difference of p and q (the offset from q to p)
+func (p Point) Sub(q Point) Point {
+	return Point{X: p.X - q.X, Y: p.Y - q.Y}
+}
+
+// Scale returns p with both of its values multiplied by factor
+func (p Point) Scale(factor int) Point {
+	return Point{X: p.X * factor, Y: p.Y * factor}
+}
+
+// Manhattan returns the Manhattan (taxicab) distance between p and q: the number of steps
+// north, east, south or west it takes to get from one to the other
+func (p Point) Manhattan(q Point) int {
+	return abs(p.X-q.X) + abs(p.Y-q.Y)
+}
+
+// RotateClockwise returns p rotated a quarter turn clockwise around 0, 0, so the delta of
+// North becomes the delta of East
+func (p Point) RotateClockwise() Point {
+	return Point{X: -p.Y, Y: p.X}
+}
+
+// RotateCounterClockwise returns p rotated a quarter turn counter-clockwise around 0, 0, so
+// the delta of North becomes the delta of West
+func (p Point) RotateCounterClockwise() Point {
+	return Point{X: p.Y, Y: -p.X}
+}
+
+// Move returns the point one step from p in the direction d
+func (p Point) Move(d Direction) Point {
+	return p.Add(d.Delta())
+}
+
+// String returns the point as x,y
+func (p Point) String() string {
+	return fmt.Sprintf("%d,%d", p.X, p.Y)
+}
+
+// Direction is one of the four directions that can be moved in on a grid
+type Direction int
+
+const (
+	North Direction = iota
+	East
+	South
+	West
+)
+
+// Directions is every Direction, clockwise from North
+var Directions = [4]Direction{North, East, South, West}
+
+// the delta, name and arrow of each direction
+var directions = [4]struct {
+	delta Point
+	name  string
+	arrow rune
+}{
+	North: {Point{X: 0, Y: -1}, "north", '^'},
+	East:  {Point{X: 1, Y: 0}, "east", '>'},
+	South: {Point{X: 0, Y: 1}, "south", 'v'},
+	West:  {Point{X: -1, Y: 0}, "west", '<'},
+}
+
+// ParseDirection returns the direction of an arrow (^, >, v or <), and whether r is one
+func ParseDirection(r rune) (Direction, bool) {
+	for _, d := range Directions {
+		if directions[d].arrow == r {
+			return d, true
+		}
+	}
+
+	return North, false
+}
+
+// TurnRight returns the direction a quarter turn clockwise from d
+func (d Direction) TurnRight() Direction {
+	return (d + 1) % 4
+}
+
+// TurnLeft returns the direction a quarter turn counter-clockwise from d
+func (d Direction) TurnLeft() Direction {
+	return (d + 3) % 4
+}
+
+// Reverse returns the opposite direction to d
+func (d Direction) Reverse() Direction {
+	return (d + 2) % 4
+}
+
+// Delta returns the change in position of a step in the direction d
+func (d Direction) Delta() Point {
+	return directions[d].delta
+}
+
+// Arrow returns the arrow (^, >, v or <) that represents the direction d in the inputs
+func (d Direction) Arrow() rune {
+	return directions[d].arrow
+}
+
+// String returns the name of the direction d
+func (d Direction) String() string {
+	if d < North || d > West {
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+
+	return directions[d].name
+}
+
+// abs returns the absolute value of x
+func abs(x int) int {
+	if x < 0 {
+		return -x
+	}
+
+	return x
+}
@@ -0,0 +1,68 @@
+package geometry
+
+import (
+	"testing"
+)
+
+func TestPoint(t *testing.T) {
+	p, q := Point{X: 3, Y: -2}, Point{X: 1, Y: 4}
+
+	tests := []struct {
+		name string
+		got  Point
+		want Point
+	}{
+		{"Add", p.Add(q), Point{X: 4, Y: 2}},
+		{"Sub", p.Sub(q), Point{X: 2, Y: -6}},
+		{"Scale", p.Scale(-2), Point{X: -6, Y: 4}},
+		{"RotateClockwise", p.RotateClockwise(), Point{X: 2, Y: 3}},
+		{"RotateCounterClockwise", p.RotateCounterClockwise(), Point{X: -2, Y: -3}},
+		{"Move", p.Move(North), Point{X: 3, Y: -3}},
+	}
+
+	for _, test := range tests {
+		if test.got != test.want {
+			t.Errorf("%s Test:\nwant %v\ngot %v\n", test.name, test.want, test.got)
+		}
+	}
+
+	if got := p.Manhattan(q); got != 8 {
+		t.Errorf("Manhattan Test:\nwant 8\ngot %v\n", got)
+	}
+
+	if got := p.String(); got != "3,-2" {
+		t.Errorf("String Test:\nwant 3,-2\ngot %v\n", got)
+	}
+}
+
+func TestDirection(t *testing.T) {
+	for _, d := range Directions {
+		if got := d.Delta().RotateClockwise(); got != d.TurnRight().Delta() {
+			t.Errorf("TurnRight Test: %v\nwant %v\ngot %v\n", d, d.TurnRight().Delta(), got)
+		}
+
+		if got := d.Delta().RotateCounterClockwise(); got != d.TurnLeft().Delta() {
+			t.Errorf("TurnLeft Test: %v\nwant %v\ngot %v\n", d, d.TurnLeft().Delta(), got)
+		}
+
+		if got := d.Reverse().Delta(); got != d.Delta().Scale(-1) {
+			t.Errorf("Reverse Test: %v\nwant %v\ngot %v\n", d, d.Delta().Scale(-1), got)
+		}
+
+		if got, ok := ParseDirection(d.Arrow()); !ok || got != d {
+			t.Errorf("ParseDirection Test: %c\nwant %v\ngot %v %v\n", d.Arrow(), d, got, ok)
+		}
+	}
+
+	if got := North.TurnLeft(); got != West {
+		t.Errorf("TurnLeft (North) Test:\nwant %v\ngot %v\n", West, got)
+	}
+
+	if got := East.Delta(); got != (Point{X: 1, Y: 0}) {
+		t.Errorf("Delta (East) Test:\nwant 1,0\ngot %v\n", got)
+	}
+
+	if _, ok := ParseDirection('x'); ok {
+		t.Errorf("ParseDirection (invalid) Test:\nwant false\ngot true\n")
+	}
+}
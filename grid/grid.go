@@ -0,0 +1,314 @@
+// grid.go provides a two-dimensional grid of values, such as the character maps that many of
+// the exercises are played out on
+package grid
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+)
+
+// the offsets of the neighbours of a cell: the first four are north, east, south and west,
+// and the last four are the diagonals, clockwise from north-east
+var offsets = [8]geometry.Point{
+	geometry.North.Delta(), geometry.East.Delta(), geometry.South.Delta(), geometry.West.Delta(),
+	{X: 1, Y: -1}, {X: 1, Y: 1}, {X: -1, Y: 1}, {X: -1, Y: -1},
+}
+
+// Grid is a rectangular grid of values. A cell is addressed by its column (X) and row (Y),
+// with 0, 0 the top-left cell, as a geometry.Point is. The zero value is an empty grid; use
+// New or one of the parsing functions to make a grid with cells.
+type Grid[T any] struct {
+	width, height int
+	cells         []T // the cells, row by row
+}
+
+// New returns a grid of the specified size with every cell set to the zero value of T
+func New[T any](width, height int) *Grid[T] {
+	if width <= 0 || height <= 0 {
+		return &Grid[T]{}
+	}
+
+	return &Grid[T]{width: width, height: height, cells: make([]T, width*height)}
+}
+
+// FromRows returns a grid of the rows. An error is returned if the rows aren't all the
+// same length.
+func FromRows[T any](rows [][]T) (*Grid[T], error) {
+	if len(rows) == 0 || len(rows[0]) == 0 {
+		return &Grid[T]{}, nil
+	}
+
+	g := New[T](len(rows[0]), len(rows))
+	for y, row := range rows {
+		if len(row) != g.width {
+			return nil, fmt.Errorf("row %d has %d cells, expected %d", y+1, len(row), g.width)
+		}
+
+		copy(g.Row(y), row)
+	}
+
+	return g, nil
+}
+
+// Parse returns a grid of the characters of the lines. A fileprocessing.ParseError is
+// returned if the lines don't form a grid.
+func Parse(lines []string) (*Grid[rune], error) {
+	return ParseFunc(lines, func(r rune) (rune, error) { return r, nil })
+}
+
+// ParseFunc returns a grid of the values returned by parse for each character of the lines.
+// A fileprocessing.ParseError is returned if the lines don't form a grid or parse returns an
+// error.
+func ParseFunc[T any](lines []string, parse func(r rune) (T, error)) (*Grid[T], error) {
+	rows, err := fileprocessing.ParseGridFunc(lines, parse)
+	if err != nil {
+		return nil, err
+	}
+
+	return FromRows(rows)
+}
+
+// Width returns the number of columns of the grid
+func (g *Grid[T]) Width() int {
+	return g.width
+}
+
+// Height returns the number of rows of the grid
+func (g *Grid[T]) Height() int {
+	return g.height
+}
+
+// InBounds returns true if x, y is a cell of the grid
+func (g *Grid[T]) InBounds(x, y int) bool {
+	return x >= 0 && x < g.width && y >= 0 && y < g.height
+}
+
+// Get returns the value of the cell at x, y and true, or the zero value and false if x, y
+// is outside the grid
+func (g *Grid[T]) Get(x, y int) (T, bool) {
+	if !g.InBounds(x, y) {
+		var zero T
+		return zero, false
+	}
+
+	return g.cells[y*g.width+x], true
+}
+
+// At returns the value of the cell at x, y. It panics if x, y is outside the grid.
+func (g *Grid[T]) At(x, y int) T {
+	g.check(x, y)
+	return g.cells[y*g.width+x]
+}
+
+// Set sets the value of the cell at x, y. It panics if x, y is outside the grid.
+func (g *Grid[T]) Set(x, y int, value T) {
+	g.check(x, y)
+	g.cells[y*g.width+x] = value
+}
+
+// Fill sets every cell of the grid to value
+func (g *Grid[T]) Fill(value T) {
+	for i := range g.cells {
+		g.cells[i] = value
+	}
+}
+
+// check panics if x, y is outside the grid
+func (g *Grid[T]) check(x, y int) {
+	if !g.InBounds(x, y) {
+		panic(fmt.Sprintf("grid: %d, %d is outside the %dx%d grid", x, y, g.width, g.height))
+	}
+}
+
+// Neighbours returns the positions of the cells north, east, south and west of x, y that
+// are inside the grid, in that order
+func (g *Grid[T]) Neighbours(x, y int) []geometry.Point {
+	return g.neighbours(x, y, offsets[:4])
+}
+
+// Neighbours8 returns the positions of the (up to) eight cells around x, y that are inside
+// the grid: north, east, south and west, followed by the diagonals
+func (g *Grid[T]) Neighbours8(x, y int) []geometry.Point {
+	return g.neighbours(x, y, offsets[:])
+}
+
+// neighbours returns the positions at the offsets from x, y that are inside the grid
+func (g *Grid[T]) neighbours(x, y int, offsets []geometry.Point) []geometry.Point {
+	neighbours := make([]geometry.Point, 0, len(offsets))
+	for _, offset := range offsets {
+		if g.InBounds(x+offset.X, y+offset.Y) {
+			neighbours = append(neighbours, geometry.Point{X: x + offset.X, Y: y + offset.Y})
+		}
+	}
+
+	return neighbours
+}
+
+// Find returns the position of the first cell (row by row) whose value matches, and
+// whether there is one
+func (g *Grid[T]) Find(match func(T) bool) (geometry.Point, bool) {
+	for i, value := range g.cells {
+		if match(value) {
+			return geometry.Point{X: i % g.width, Y: i / g.width}, true
+		}
+	}
+
+	return geometry.Point{}, false
+}
+
+// FindAll returns the positions of every cell (row by row) whose value matches
+func (g *Grid[T]) FindAll(match func(T) bool) []geometry.Point {
+	var positions []geometry.Point
+	for i, value := range g.cells {
+		if match(value) {
+			positions = append(positions, geometry.Point{X: i % g.width, Y: i / g.width})
+		}
+	}
+
+	return positions
+}
+
+// Count returns the number of cells whose value matches
+func (g *Grid[T]) Count(match func(T) bool) int {
+	count := 0
+	for _, value := range g.cells {
+		if match(value) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Equal returns a function for Find, FindAll and Count that matches the cells equal to value
+func Equal[T comparable](value T) func(T) bool {
+	return func(v T) bool {
+		return v == value
+	}
+}
+
+// Copy returns a copy of the grid that doesn't share its cells
+func (g *Grid[T]) Copy() *Grid[T] {
+	return &Grid[T]{width: g.width, height: g.height, cells: append([]T(nil), g.cells...)}
+}
+
+// Transpose returns a copy of the grid with its rows and columns swapped
+func (g *Grid[T]) Transpose() *Grid[T] {
+	transposed := New[T](g.height, g.width)
+	for pos, value := range g.All() {
+		transposed.Set(pos.Y, pos.X, value)
+	}
+
+	return transposed
+}
+
+// RotateClockwise returns a copy of the grid rotated a quarter turn clockwise
+func (g *Grid[T]) RotateClockwise() *Grid[T] {
+	rotated := New[T](g.height, g.width)
+	for pos, value := range g.All() {
+		rotated.Set(g.height-1-pos.Y, pos.X, value)
+	}
+
+	return rotated
+}
+
+// RotateCounterClockwise returns a copy of the grid rotated a quarter turn counter-clockwise
+func (g *Grid[T]) RotateCounterClockwise() *Grid[T] {
+	rotated := New[T](g.height, g.width)
+	for pos, value := range g.All() {
+		rotated.Set(pos.Y, g.width-1-pos.X, value)
+	}
+
+	return rotated
+}
+
+// Row returns the cells of row y. The slice shares the cells of the grid, so setting its
+// values sets the cells.
+func (g *Grid[T]) Row(y int) []T {
+	g.check(0, y)
+	return g.cells[y*g.width : (y+1)*g.width : (y+1)*g.width]
+}
+
+// Column returns a copy of the cells of column x
+func (g *Grid[T]) Column(x int) []T {
+	g.check(x, 0)
+
+	column := make([]T, g.height)
+	for y := range column {
+		column[y] = g.cells[y*g.width+x]
+	}
+
+	return column
+}
+
+// All returns an iterator over the position and value of every cell, row by row
+func (g *Grid[T]) All() iter.Seq2[geometry.Point, T] {
+	return func(yield func(geometry.Point, T) bool) {
+		for i, value := range g.cells {
+			if !yield(geometry.Point{X: i % g.width, Y: i / g.width}, value) {
+				return
+			}
+		}
+	}
+}
+
+// Rows returns an iterator over the index and cells of each row (see Row)
+func (g *Grid[T]) Rows() iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		for y := range g.height {
+			if !yield(y, g.Row(y)) {
+				return
+			}
+		}
+	}
+}
+
+// Columns returns an iterator over the index and cells of each column (see Column)
+func (g *Grid[T]) Columns() iter.Seq2[int, []T] {
+	return func(yield func(int, []T) bool) {
+		for x := range g.width {
+			if !yield(x, g.Column(x)) {
+				return
+			}
+		}
+	}
+}
+
+// Render writes the grid to w a row per line, with each cell written as the text returned by
+// format
+func (g *Grid[T]) Render(w io.Writer, format func(T) string) error {
+	var builder strings.Builder
+	for _, row := range g.Rows() {
+		for _, value := range row {
+			builder.WriteString(format(value))
+		}
+
+		builder.WriteByte('\n')
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	return err
+}
+
+// String returns the grid a row per line. Runes and bytes are written as the characters
+// they represent and other values as they are formatted by fmt.Sprint.
+func (g *Grid[T]) String() string {
+	var builder strings.Builder
+	g.Render(&builder, func(value T) string {
+		switch v := any(value).(type) {
+		case rune:
+			return string(v)
+		case byte:
+			return string(rune(v))
+		}
+
+		return fmt.Sprint(value)
+	})
+
+	return builder.String()
+}
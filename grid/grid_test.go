@@ -0,0 +1,194 @@
+package grid
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/geometry"
+)
+
+func TestParse(t *testing.T) {
+	g, err := Parse([]string{"#.#", "..@"})
+	if err != nil {
+		t.Fatalf("Parse Test:\nwant no error\ngot %v\n", err)
+	}
+
+	if g.Width() != 3 || g.Height() != 2 {
+		t.Errorf("Parse Test:\nwant 3x2\ngot %dx%d\n", g.Width(), g.Height())
+	}
+
+	if got := g.At(2, 1); got != '@' {
+		t.Errorf("Parse (At) Test:\nwant %q\ngot %q\n", '@', got)
+	}
+
+	var parseErr *fileprocessing.ParseError
+	if _, err := Parse([]string{"#.#", ".."}); !errors.As(err, &parseErr) {
+		t.Errorf("Parse (ragged) Test:\nwant a ParseError\ngot %v\n", err)
+	}
+
+	if _, err := FromRows([][]int{{1, 2}, {3}}); err == nil {
+		t.Errorf("FromRows (ragged) Test:\nwant an error\ngot nil\n")
+	}
+}
+
+func TestAccess(t *testing.T) {
+	g := New[int](3, 2)
+	g.Set(1, 1, 5)
+
+	if got, ok := g.Get(1, 1); !ok || got != 5 {
+		t.Errorf("Get Test:\nwant 5 true\ngot %v %v\n", got, ok)
+	}
+
+	for _, pos := range []geometry.Point{{X: -1, Y: 0}, {X: 3, Y: 0}, {X: 0, Y: -1}, {X: 0, Y: 2}} {
+		if got, ok := g.Get(pos.X, pos.Y); ok || got != 0 {
+			t.Errorf("Get (out of bounds) Test: %v\nwant 0 false\ngot %v %v\n", pos, got, ok)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("At (out of bounds) Test:\nwant a panic\ngot none\n")
+		}
+	}()
+
+	g.At(3, 0)
+}
+
+func TestNeighbours(t *testing.T) {
+	g := New[rune](3, 3)
+
+	got := g.Neighbours(1, 1)
+	if want := []geometry.Point{{X: 1, Y: 0}, {X: 2, Y: 1}, {X: 1, Y: 2}, {X: 0, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbours Test:\nwant %v\ngot %v\n", want, got)
+	}
+
+	got = g.Neighbours(0, 0)
+	if want := []geometry.Point{{X: 1, Y: 0}, {X: 0, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbours (corner) Test:\nwant %v\ngot %v\n", want, got)
+	}
+
+	if got := g.Neighbours8(1, 1); len(got) != 8 {
+		t.Errorf("Neighbours8 Test:\nwant 8 neighbours\ngot %v\n", got)
+	}
+
+	got = g.Neighbours8(0, 0)
+	if want := []geometry.Point{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Neighbours8 (corner) Test:\nwant %v\ngot %v\n", want, got)
+	}
+}
+
+func TestFind(t *testing.T) {
+	g, _ := Parse([]string{"..#", "#.#"})
+
+	if got, found := g.Find(Equal('#')); !found || got != (geometry.Point{X: 2, Y: 0}) {
+		t.Errorf("Find Test:\nwant {2 0} true\ngot %v %v\n", got, found)
+	}
+
+	if _, found := g.Find(Equal('@')); found {
+		t.Errorf("Find (missing) Test:\nwant false\ngot true\n")
+	}
+
+	got := g.FindAll(Equal('#'))
+	if want := []geometry.Point{{X: 2, Y: 0}, {X: 0, Y: 1}, {X: 2, Y: 1}}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FindAll Test:\nwant %v\ngot %v\n", want, got)
+	}
+
+	if got := g.Count(Equal('.')); got != 3 {
+		t.Errorf("Count Test:\nwant 3\ngot %v\n", got)
+	}
+}
+
+func TestCopy(t *testing.T) {
+	g, _ := Parse([]string{"ab", "cd"})
+	c := g.Copy()
+	c.Set(0, 0, 'z')
+
+	if g.At(0, 0) != 'a' || c.At(0, 0) != 'z' {
+		t.Errorf("Copy Test:\nwant a and z\ngot %q and %q\n", g.At(0, 0), c.At(0, 0))
+	}
+}
+
+func TestReshape(t *testing.T) {
+	g, _ := Parse([]string{"abc", "def"})
+
+	tests := []struct {
+		name string
+		got  *Grid[rune]
+		want string
+	}{
+		{"Transpose", g.Transpose(), "ad\nbe\ncf\n"},
+		{"RotateClockwise", g.RotateClockwise(), "da\neb\nfc\n"},
+		{"RotateCounterClockwise", g.RotateCounterClockwise(), "cf\nbe\nad\n"},
+		{"RotateClockwise (full turn)", g.RotateClockwise().RotateClockwise().RotateClockwise().RotateClockwise(), "abc\ndef\n"},
+	}
+
+	for _, test := range tests {
+		if got := test.got.String(); got != test.want {
+			t.Errorf("%s Test:\nwant %q\ngot %q\n", test.name, test.want, got)
+		}
+	}
+}
+
+func TestIteration(t *testing.T) {
+	g, _ := FromRows([][]int{{1, 2, 3}, {4, 5, 6}})
+
+	var rows [][]int
+	for _, row := range g.Rows() {
+		rows = append(rows, row)
+	}
+
+	if want := [][]int{{1, 2, 3}, {4, 5, 6}}; !reflect.DeepEqual(rows, want) {
+		t.Errorf("Rows Test:\nwant %v\ngot %v\n", want, rows)
+	}
+
+	var columns [][]int
+	for _, column := range g.Columns() {
+		columns = append(columns, column)
+	}
+
+	if want := [][]int{{1, 4}, {2, 5}, {3, 6}}; !reflect.DeepEqual(columns, want) {
+		t.Errorf("Columns Test:\nwant %v\ngot %v\n", want, columns)
+	}
+
+	sum := 0
+	for pos, value := range g.All() {
+		if g.At(pos.X, pos.Y) != value {
+			t.Errorf("All Test: %v\nwant %v\ngot %v\n", pos, g.At(pos.X, pos.Y), value)
+		}
+
+		sum += value
+	}
+
+	if sum != 21 {
+		t.Errorf("All (sum) Test:\nwant 21\ngot %v\n", sum)
+	}
+
+	g.Row(1)[0] = 9
+	if got := g.At(0, 1); got != 9 {
+		t.Errorf("Row (shared) Test:\nwant 9\ngot %v\n", got)
+	}
+}
+
+func TestRender(t *testing.T) {
+	g, _ := FromRows([][]int{{1, 0}, {0, 12}})
+
+	if got, want := g.String(), "10\n012\n"; got != want {
+		t.Errorf("String Test:\nwant %q\ngot %q\n", want, got)
+	}
+
+	var builder strings.Builder
+	g.Render(&builder, func(value int) string {
+		if value == 0 {
+			return "."
+		}
+
+		return "#"
+	})
+
+	if got, want := builder.String(), "#.\n.#\n"; got != want {
+		t.Errorf("Render Test:\nwant %q\ngot %q\n", want, got)
+	}
+}
@@ -3,134 +3,234 @@
 // entire year. We'll see if this year's the year that I finally break through.
 //
 // Each day will be displayed in a command-line menu that allows a user to specify the
-// day to run and the data file input.
+// day to run. The exercises can also be run by scripts through the commands described
+// in the usage (run with -help to see them).
 package main
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"os/signal"
+	"slices"
 	"strconv"
 	"strings"
 
+	"github.com/trentnix/aoc2024/aoc"
 	"github.com/trentnix/aoc2024/exercise"
-	"github.com/trentnix/aoc2024/fileprocessing"
 )
 
-// main runs the exercise for the specified day. If no day is specified, a
-// menu is displayed that allows the user to select a day to run. If the user
-// selects a day to run, the default input file is used.
+// main runs the command specified on the command line. If no command is specified, a
+// menu is displayed that allows the user to select a day to run using the default input
+// file.
 //
-// If the exercise is specified, an optional input file can also be specified. Otherwise,
-// the default input file is used.
+// The commands are:
+//
+//	run    runs a day (or a single part of a day) against its default or a specified input
+//	list   lists the available exercises
+//	bench  times repeated runs of a day
+//	check  verifies the answers of each day against the stored answers
+//	fetch  downloads the input of a day from the Advent of Code site
+//	submit submits the answer to a part of a day to the Advent of Code site
+//	show   shows the puzzle description of a day
+//	search searches the puzzle descriptions
+//	new    generates a new day from the DayX template
 func main() {
-	choice := -1
-	inputFile := ""
+	registrations := exercise.Registrations()
+	if len(registrations) <= 0 {
+		log.Fatalf("There are no exercises available.")
+	}
 
-	writer := os.Stdout
+	if len(os.Args) < 2 {
+		interactive(os.Stdout, registrations)
+		return
+	}
 
-	exercises := exercise.GetExercises()
-	numExercises := len(exercises)
+	name, args := os.Args[1], os.Args[2:]
+	if name == "-h" || name == "-help" || name == "--help" || name == "help" {
+		usage(os.Stdout)
+		return
+	}
 
-	if numExercises <= 0 {
-		log.Fatalf("There are no exercises available.")
+	cmd, ok := findCommand(name)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n\n", name)
+		usage(os.Stderr)
+		os.Exit(exitUsage)
 	}
 
-	// check for a command-line argument with a preselection
-	argCount := len(os.Args)
-	if argCount > 1 {
-		selectionNum, err := strconv.Atoi(os.Args[1])
-		if err != nil || numExercises > selectionNum {
-			// the first parameter is invalid
-			failureMessage := "Invalid exercise"
-			if err != nil {
-				failureMessage += fmt.Sprintf(": %v", err)
-			}
+	// an interrupt cancels the running exercises so their results are still reported
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	code := cmd.run(ctx, os.Stdout, registrations, args)
+	stop()
 
-			log.Fatalf("%s", failureMessage)
+	os.Exit(code)
+}
+
+// interactive displays the menu and runs the selected exercise against its default input
+// file until the user chooses to exit. The menu shows the days of the most recent year
+// until the user enters another year.
+func interactive(writer io.Writer, registrations []exercise.Registration) {
+	fmt.Fprint(writer, "\n")
+	fmt.Fprintln(writer, "Welcome to solutions for the Advent of Code!")
+
+	reader := bufio.NewReader(os.Stdin)
+	year := latestYear(registrations)
+
+	// the day that was run last and its answers, so they can be submitted
+	var last *exercise.Registration
+	var lastResult exercise.Result
+
+	for {
+		// 'selection' captures the user's selection for processing
+		selection, ok := menu(reader, registrations, year)
+		if !ok {
+			// stdin has been closed, so there is nothing left to select
 			return
-		} else {
-			choice = selectionNum
 		}
 
-		if argCount > 2 {
-			inputFile = os.Args[2]
+		fmt.Fprint(writer, "\n")
+
+		if selection == "0" {
+			// the user has specified the 'Exit' choice
+			fmt.Fprintln(writer, "Exiting...")
+			fmt.Fprint(writer, "\n")
+			return
 		}
 
-		if inputFile == "" {
-			exercises[selectionNum-1].Run(writer)
-		} else {
-			// a file was specified - read the contents of the file and pass it to the specified
-			// exercise
-			input, err := fileprocessing.ReadFile(inputFile)
-			if err != nil {
-				// error reading from the specified file
-				log.Fatalf("Could not process the specified file %s: %v", inputFile, err)
-				return
+		if query, found := strings.CutPrefix(selection, "s "); found {
+			// the user wants to find a day by searching the descriptions
+			if _, err := searchPuzzles(writer, registrations, query, 5); err != nil {
+				fmt.Fprintln(writer, err)
 			}
 
-			exercises[selectionNum].RunFromInput(writer, input)
+			continue
 		}
 
-	} else {
-		// there is no argument so show the full menu
-		fmt.Print("\n")
-		fmt.Println("Welcome to solutions for the Advent of Code 2024!")
-	}
+		if part, found := strings.CutPrefix(selection, "u "); found {
+			// the user wants to submit an answer of the day that was run last
+			submitLast(writer, last, lastResult, strings.TrimSpace(part))
+			continue
+		}
 
-	for {
-		if choice < 0 {
-			// no command-line menu choice was selected or the menu needs to be re-displayed
-			// 'selection' captures the user's selection for processing
-			selection := menu(exercises)
-			selectionNum, err := strconv.Atoi(selection)
+		if day, found := strings.CutPrefix(selection, "r "); found {
+			// the user wants to read the description of a day
+			registration, err := parseDay(registrations, year, strings.TrimSpace(day))
 			if err != nil {
-				fmt.Print("invalid choice:", err)
+				fmt.Fprintln(writer, "Invalid choice. Please try again.")
 				continue
-			} else {
-				choice = selectionNum
 			}
+
+			if err := showPuzzle(writer, registration, 0, isTerminal(os.Stdout)); err != nil {
+				fmt.Fprintln(writer, err)
+			}
+
+			continue
 		}
 
-		fmt.Print("\n")
+		if choice, err := strconv.Atoi(selection); err == nil && slices.Contains(years(registrations), choice) {
+			// the user has specified a year, so show the days of that year
+			year = choice
+			continue
+		}
 
-		if 0 < choice && choice <= numExercises { // the choice is valid
-			exercises[choice-1].Run(writer)
-		} else if choice == 0 {
-			// the user has specified the 'Exit' choice
-			fmt.Println("Exiting...")
-			fmt.Print("\n")
-			return
-		} else {
+		registration, err := parseDay(registrations, year, selection)
+		if err != nil {
 			// the choice isn't valid
-			fmt.Println("Invalid choice. Please try again.")
+			fmt.Fprintln(writer, "Invalid choice. Please try again.")
+			continue
 		}
 
-		// We are looping through, so any command-line choice should be rendered moot.
-		// Resetting choice does just that.
-		choice = -1
+		result, err := registration.Exercise.Run(context.Background())
+		printResult(writer, result, err)
+		printHistory(writer, registration, result)
+
+		last, lastResult = &registration, result
 	}
 }
 
-// menu takes an array of exercises and builds a command-line menu to present to the
-// user. The function returns (as a string value) the selection made by the user.
-func menu(exercises []exercise.Exercise) string {
-	reader := bufio.NewReader(os.Stdin)
+// submitLast submits the answer to the specified part of the result of the day that was run
+// last, unless the day's submission history shows it is wrong
+func submitLast(writer io.Writer, last *exercise.Registration, result exercise.Result, part string) {
+	if last == nil {
+		fmt.Fprintln(writer, "Run a day before submitting its answers.")
+		return
+	}
+
+	for _, answer := range result.Answers {
+		if strconv.Itoa(answer.Part) != part || answer.Err != nil || answer.String() == "" {
+			continue
+		}
+
+		client := aoc.NewClient("")
+		if client.Session == "" {
+			fmt.Fprintln(writer, aoc.ErrNoSession)
+			return
+		}
+
+		if _, err := submitAnswer(context.Background(), writer, client, *last, answer.Part, answer.String(), false); err != nil {
+			fmt.Fprintln(writer, err)
+		}
+
+		return
+	}
+
+	fmt.Fprintf(writer, "%s doesn't have an answer to part %s to submit.\n", last.Name(), part)
+}
 
+// menu takes the registered exercises and builds a command-line menu of the days of the
+// specified year to present to the user. The function returns (as a string value) the
+// selection made by the user. false is returned if nothing more can be read from reader.
+func menu(reader *bufio.Reader, registrations []exercise.Registration, year int) (string, bool) {
 	fmt.Print("\n\n")
 	fmt.Println("Pick an option below:")
-	for index, ex := range exercises {
-		fmt.Println((index + 1), ":", ex.GetName())
+
+	selected, _ := selectExercises(registrations, year, "")
+	for _, registration := range selected {
+		fmt.Println(registration.Day, ":", registration.Name(), "-", registration.Title)
 	}
-	if len(exercises) <= 0 {
+	if len(selected) <= 0 {
 		fmt.Println("No exercises available")
 	}
+	fmt.Println("r N : Read the puzzle description of day N")
+	fmt.Println("s WORDS : Search the puzzle descriptions for WORDS")
+	fmt.Println("u P : Submit the answer to part P of the day that was run last")
 	fmt.Println("0 : Exit")
+
+	if all := years(registrations); len(all) > 1 {
+		fmt.Println("\nEnter a year to show its days, or YYYY/N to run day N of another year:", all)
+	}
+
 	fmt.Print("\nChoose wisely: ")
 
-	input, _ := reader.ReadString('\n')
+	input, err := reader.ReadString('\n')
+	if err != nil && input == "" {
+		fmt.Print("\n")
+		return "", false
+	}
+
 	choice := strings.TrimSpace(input)
 
-	return choice
+	return choice, true
+}
+
+// printResult renders the answers produced by an exercise to the specified writer. If
+// the exercise failed to run, the error is rendered after any answers produced before
+// the failure.
+func printResult(w io.Writer, result exercise.Result, err error) {
+	for _, answer := range result.Answers {
+		if answer.Err != nil {
+			fmt.Fprintf(w, "%s - Part %d - %v\n", result.Name, answer.Part, answer.Err)
+			continue
+		}
+
+		fmt.Fprintf(w, "%s - Part %d - %s: %s\n", result.Name, answer.Part, answer.Label, answer)
+	}
+
+	if err != nil {
+		fmt.Fprintf(w, "%s - %v\n", result.Name, err)
+	}
 }
@@ -0,0 +1,279 @@
+// puzzle.go parses the puzzle descriptions stored as data/YYYY/dayN/dayN.md so the examples
+// in them can be used by tests
+package puzzle
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
+)
+
+// Puzzle is the description of a day's puzzle
+type Puzzle struct {
+	Day   int    // the day of the puzzle, or 0 if the description doesn't have a heading
+	Title string // the title of the puzzle, without the day
+	Parts []Part
+}
+
+// Part is the description of a single part of a puzzle
+type Part struct {
+	Number   int
+	Line     int       // the line number (starting at 1) of the first line of the text of the part
+	Lines    []string  // the text of the part, not including its heading
+	Examples []Example // the example inputs, in the order they appear
+	Answer   string    // the answer to the examples, or "" if it isn't highlighted in the text
+}
+
+// Example is an example input from the description of a puzzle
+type Example struct {
+	Line  int      // the line number (starting at 1) of the first line of the example
+	Lines []string // the example input
+}
+
+var (
+	// titlePattern matches the heading of a puzzle, such as "--- Day 1: Historian Hysteria ---"
+	titlePattern = regexp.MustCompile(`^--- Day (\d+): (.*) ---$`)
+
+	// partPattern matches the heading of the second part of a puzzle
+	partPattern = regexp.MustCompile(`^--- Part Two ---$`)
+
+	// emphasisPattern matches highlighted text, such as <em>11</em>, **11** or *11*. A single
+	// asterisk is also a multiplication (as in "2*4 + 5*5"), so *11* is only highlighted text
+	// when there is whitespace, a line boundary or punctuation on both sides of it. The text
+	// around *11* is matched too, so it can be kept.
+	emphasisPattern = regexp.MustCompile(`<em>([^<]+)</em>|\*\*([^*]+)\*\*|(^|[\s(\["'])\*([^*\s](?:[^*]*[^*\s])?)\*($|[\s.,;:!?)\]"'])`)
+
+	// answerPattern matches an answer that can be submitted, such as 11, abc or 6,7
+	answerPattern = regexp.MustCompile(`^[\w,-]+$`)
+
+	// sentencePattern matches text that ends like a sentence of prose
+	sentencePattern = regexp.MustCompile(`[.!?:)"]$`)
+)
+
+// Filename returns the name of the markdown file describing the specified day, stored in
+// the data directory
+func Filename(dataDir string, year, day int) string {
+	return filepath.Join(dataDir, fmt.Sprint(year), fmt.Sprintf("day%d", day), fmt.Sprintf("day%d.md", day))
+}
+
+// Load reads and parses the puzzle description in the specified markdown file
+func Load(filename string) (*Puzzle, error) {
+	file, err := fileprocessing.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	p, err := Parse(file)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	return p, nil
+}
+
+// Parse parses a puzzle description. The description is split into parts at the
+// "--- Part Two ---" heading, and the examples of each part are extracted.
+//
+// An example is either a fenced code block or, in descriptions exported as plain text, the
+// lines that follow a line like "For example:" up to the next line of prose. The answer to a
+// part is the last highlighted text before the paragraph that asks the question. Plain text
+// exports don't highlight the answers, so Answer is empty for them.
+func Parse(r io.Reader) (*Puzzle, error) {
+	lines, err := fileprocessing.Read(r)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &Puzzle{}
+	part := Part{Number: 1, Line: 1}
+
+	for i, line := range lines {
+		if matches := titlePattern.FindStringSubmatch(line); matches != nil && p.Title == "" && len(part.Lines) == 0 {
+			p.Day, _ = strconv.Atoi(matches[1])
+			p.Title = matches[2]
+			part.Line = i + 2
+			continue
+		}
+
+		if partPattern.MatchString(line) {
+			part.Examples, part.Answer = examples(part.Lines, part.Line-1), answer(part.Lines)
+			p.Parts = append(p.Parts, part)
+			part = Part{Number: 2, Line: i + 2}
+			continue
+		}
+
+		part.Lines = append(part.Lines, line)
+	}
+
+	if len(part.Lines) == 0 && len(p.Parts) == 0 {
+		return nil, fmt.Errorf("the puzzle description is empty")
+	}
+
+	part.Examples, part.Answer = examples(part.Lines, part.Line-1), answer(part.Lines)
+	p.Parts = append(p.Parts, part)
+
+	return p, nil
+}
+
+// Part returns the specified part of the puzzle
+func (p *Puzzle) Part(number int) (Part, bool) {
+	for _, part := range p.Parts {
+		if part.Number == number {
+			return part, true
+		}
+	}
+
+	return Part{}, false
+}
+
+// Example returns the first example of the specified part. The second part of most puzzles
+// reuses the examples of the first, so the first example of part 1 is returned for part 2
+// if part 2 doesn't have one of its own.
+func (p *Puzzle) Example(part int) (Example, bool) {
+	for number := part; number >= 1; number-- {
+		if found, ok := p.Part(number); ok && len(found.Examples) > 0 {
+			return found.Examples[0], true
+		}
+	}
+
+	return Example{}, false
+}
+
+// examples returns the example inputs in the lines of a part. offset is the index of the
+// first line of the part in the description, so the line numbers can be reported.
+func examples(lines []string, offset int) []Example {
+	var found []Example
+
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		var example Example
+		switch {
+		case strings.HasPrefix(line, "```"):
+			example.Line = offset + i + 2
+			for i++; i < len(lines) && !strings.HasPrefix(strings.TrimSpace(lines[i]), "```"); i++ {
+				example.Lines = append(example.Lines, lines[i])
+			}
+		case introducesExample(line):
+			// the example ends at the next line of prose, or at a caption (such as "It
+			// contains:") once the example has started
+			end, started := i, false
+			for end+1 < len(lines) {
+				next := lines[end+1]
+				if isProse(next) || (started && isCaption(next)) {
+					break
+				}
+
+				started = started || strings.TrimSpace(next) != ""
+				end++
+			}
+
+			first := i + 1
+			for first <= end && strings.TrimSpace(lines[first]) == "" {
+				first++
+			}
+
+			example.Line = offset + first + 1
+			example.Lines = trimBlankLines(lines[first : end+1])
+			i = end
+		default:
+			continue
+		}
+
+		if len(example.Lines) > 0 {
+			found = append(found, example)
+		}
+	}
+
+	return found
+}
+
+// answer returns the last highlighted text of the part before the paragraph that asks the
+// question, or "" if there isn't any or it isn't a value that could be an answer (such as
+// "8 + 8")
+func answer(lines []string) string {
+	// the question is asked by the last paragraph of the part
+	last := len(lines) - 1
+	for last >= 0 && strings.TrimSpace(lines[last]) == "" {
+		last--
+	}
+
+	for i := last - 1; i >= 0; i-- {
+		matches := emphasisPattern.FindAllStringSubmatch(lines[i], -1)
+		if len(matches) == 0 {
+			continue
+		}
+
+		_, text, _ := emphasis(matches[len(matches)-1])
+		if !answerPattern.MatchString(text) {
+			return ""
+		}
+
+		return text
+	}
+
+	return ""
+}
+
+// emphasis returns the highlighted text of a match of emphasisPattern, and the text matched
+// before and after its markup
+func emphasis(groups []string) (before, text, after string) {
+	switch {
+	case groups[1] != "":
+		return "", groups[1], ""
+	case groups[2] != "":
+		return "", groups[2], ""
+	}
+
+	return groups[3], groups[4], groups[5]
+}
+
+// introducesExample returns true if the line introduces an example input that follows it,
+// such as "For example:"
+func introducesExample(line string) bool {
+	return strings.HasSuffix(line, ":") && strings.Contains(strings.ToLower(line), "example")
+}
+
+// isProse returns true if the line looks like a sentence of the description rather than a
+// line of an example input
+func isProse(line string) bool {
+	line = strings.TrimSpace(line)
+	return len(strings.Fields(line)) >= 4 && sentencePattern.MatchString(line)
+}
+
+// isCaption returns true if the line is a few words that introduce what follows them, such
+// as "It contains:"
+func isCaption(line string) bool {
+	words := strings.Fields(strings.TrimSuffix(strings.TrimSpace(line), ":"))
+	if !strings.HasSuffix(strings.TrimSpace(line), ":") || len(words) < 2 {
+		return false
+	}
+
+	for _, word := range words {
+		if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsLetter(r) && r != '\'' }) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// trimBlankLines removes the blank lines from the start and end of lines
+func trimBlankLines(lines []string) []string {
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+
+	return lines
+}
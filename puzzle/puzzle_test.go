@@ -0,0 +1,146 @@
+package puzzle
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const plainDescription = `--- Day 1: Test Puzzle ---
+There are two lists of numbers.
+
+For example:
+
+3   4
+4   3
+
+1   3
+Maybe the lists are only off by a small amount! Pair up the numbers.
+
+In this example, the total distance is 11!
+
+What is the total distance between your lists?
+
+--- Part Two ---
+Here is a larger example:
+
+RRRR
+RRII
+It contains:
+
+A region of R plants.
+
+What is the similarity score?`
+
+const fencedDescription = "--- Day 2: Fenced ---\nThe input looks like this:\n\n```\n1 2\n3 4\n```\n\nThe sum is <em>10</em> and the product is *24*.\n\nWhat is the *sum*?\n"
+
+func TestParsePlainText(t *testing.T) {
+	p, err := Parse(strings.NewReader(plainDescription))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Title != "Test Puzzle" || len(p.Parts) != 2 {
+		t.Fatalf("Parse Test:\nwant %q with 2 parts\ngot %q with %d parts\n", "Test Puzzle", p.Title, len(p.Parts))
+	}
+
+	part1, _ := p.Part(1)
+	if len(part1.Examples) != 1 || !slices.Equal(part1.Examples[0].Lines, []string{"3   4", "4   3", "", "1   3"}) || part1.Examples[0].Line != 6 {
+		t.Errorf("Parse Test (part 1 example):\nwant %q on line 6\ngot %+v\n", []string{"3   4", "4   3", "", "1   3"}, part1.Examples)
+	}
+
+	if part1.Answer != "" {
+		t.Errorf("Parse Test (part 1 answer):\nwant %q\ngot %q\n", "", part1.Answer)
+	}
+
+	example, found := p.Example(2)
+	if !found || !slices.Equal(example.Lines, []string{"RRRR", "RRII"}) || example.Line != 19 {
+		t.Errorf("Parse Test (part 2 example):\nwant %q on line 19\ngot %+v\n", []string{"RRRR", "RRII"}, example)
+	}
+}
+
+func TestParseFenced(t *testing.T) {
+	p, err := Parse(strings.NewReader(fencedDescription))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	part1, _ := p.Part(1)
+	if len(part1.Examples) != 1 || !slices.Equal(part1.Examples[0].Lines, []string{"1 2", "3 4"}) || part1.Examples[0].Line != 5 {
+		t.Errorf("Parse Test (fenced example):\nwant %q on line 5\ngot %+v\n", []string{"1 2", "3 4"}, part1.Examples)
+	}
+
+	// the emphasis in the question isn't the answer
+	if part1.Answer != "24" {
+		t.Errorf("Parse Test (answer):\nwant %q\ngot %q\n", "24", part1.Answer)
+	}
+
+	// part 2 reuses the example of part 1
+	if example, found := p.Example(2); !found || example.Line != 5 {
+		t.Errorf("Parse Test (part 2 example):\nwant the part 1 example\ngot %+v\n", example)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	p, err := Load(Filename(filepath.Join("..", "data"), 2024, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	example, found := p.Example(1)
+	if p.Title != "Historian Hysteria" || !found || !slices.Equal(example.Lines, []string{"3   4", "4   3", "2   5", "1   3", "3   9", "3   3"}) {
+		t.Errorf("Load Test:\nwant the day 1 example\ngot %q %+v\n", p.Title, example)
+	}
+
+	if _, err := Load(Filename(filepath.Join("..", "data"), 2024, 26)); err == nil {
+		t.Errorf("Load Test (missing):\nwant an error\ngot %v\n", err)
+	}
+}
+
+func TestLoadAnswers(t *testing.T) {
+	// the answers to the examples of each day; "" where the description doesn't have a
+	// single answer to its examples
+	expected := map[int][2]string{
+		1: {"11", "31"}, 2: {"2", "4"}, 3: {"161", "48"}, 4: {"18", "9"}, 5: {"143", "123"},
+		6: {"41", "6"}, 7: {"3749", "11387"}, 8: {"14", "34"}, 9: {"1928", "2858"}, 10: {"36", "81"},
+		11: {"55312", ""}, 12: {"1930", "1206"}, 13: {"480", ""}, 14: {"12", ""}, 15: {"10092", "9021"},
+		16: {"7036", "45"}, 17: {"4,6,3,5,6,3,5,2,1,0", "117440"}, 18: {"22", "6,1"}, 19: {"6", "16"}, 20: {"", ""},
+		21: {"126384", ""}, 22: {"37327623", "23"}, 23: {"7", "co,de,ka,ta"}, 24: {"2024", "z00,z01,z02,z05"}, 25: {"3", ""},
+	}
+
+	for day, answers := range expected {
+		p, err := Load(Filename(filepath.Join("..", "data"), 2024, day))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, part := range p.Parts {
+			if part.Answer != "" && part.Answer != answers[part.Number-1] {
+				t.Errorf("Load Answers Test (day %d part %d):\nwant %q or no answer\ngot %q\n", day, part.Number, answers[part.Number-1], part.Answer)
+			}
+		}
+	}
+}
+
+func TestParseAnswer(t *testing.T) {
+	for _, test := range []struct {
+		description string
+		want        string
+	}{
+		{"It produces 161 (2*4 + 5*5 + 11*8 + 8*5).\n\nWhat is the sum?", ""},
+		{"Doing this would cost 80*3 tokens for the A presses and 40*1 for the B presses.\n\nWhat is the cost?", ""},
+		{"The total is *8 + 8*.\n\nWhat is the total?", ""},
+		{"The total is *16*.\n\nWhat is the total?", "16"},
+		{"The password is (*co,de,ka,ta*)\n\nWhat is the password?", "co,de,ka,ta"},
+	} {
+		p, err := Parse(strings.NewReader(test.description))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := p.Parts[0].Answer; got != test.want {
+			t.Errorf("Parse Answer Test (%q):\nwant %q\ngot %q\n", test.description, test.want, got)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+// render.go renders a puzzle description as text for reading in a terminal
+package puzzle
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// RenderOptions configures how a puzzle description is rendered
+type RenderOptions struct {
+	Width  int  // the width the text is wrapped to; 80 is used if it is less than 20
+	Styled bool // set to render the headings and highlighted text in bold with ANSI escape codes
+}
+
+// the markers that surround highlighted text while the text is wrapped. They take up no
+// space, and are replaced by the escape codes (or removed) once the text is wrapped.
+const (
+	emphasisStart = '\x01'
+	emphasisEnd   = '\x02'
+)
+
+// the ANSI escape codes used by styled text
+const (
+	bold  = "\x1b[1m"
+	reset = "\x1b[0m"
+)
+
+// exampleIndent is the indentation of the lines of the examples
+const exampleIndent = "    "
+
+// Render writes the puzzle description to w. The headings are underlined, the prose is
+// wrapped to the width in opts, and the examples are indented and left unwrapped so they
+// can be copied.
+func Render(w io.Writer, p *Puzzle, opts RenderOptions) error {
+	if opts.Width < 20 {
+		opts.Width = 80
+	}
+
+	bw := bufio.NewWriter(w)
+
+	if p.Title != "" {
+		heading(bw, fmt.Sprintf("Day %d: %s", p.Day, p.Title), '=', opts)
+	}
+
+	for _, part := range p.Parts {
+		if part.Number > 1 {
+			bw.WriteString("\n")
+			heading(bw, fmt.Sprintf("Part %d", part.Number), '-', opts)
+		}
+
+		// the examples, keyed by the index of their first line in the part
+		starts := make(map[int]Example)
+		for _, example := range part.Examples {
+			starts[example.Line-part.Line] = example
+		}
+
+		// the blank lines at the end of a part are replaced by the blank line ahead of the
+		// next heading
+		lines := part.Lines
+		for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+			lines = lines[:len(lines)-1]
+		}
+
+		for i := 0; i < len(lines); i++ {
+			if example, found := starts[i]; found {
+				for _, line := range example.Lines {
+					bw.WriteString(strings.TrimRight(exampleIndent+line, " ") + "\n")
+				}
+
+				i += len(example.Lines) - 1
+				continue
+			}
+
+			line := strings.TrimSpace(lines[i])
+			if strings.HasPrefix(line, "```") {
+				// the fences of the examples aren't shown
+				continue
+			}
+
+			for _, wrapped := range wrap(highlight(line), opts.Width) {
+				bw.WriteString(style(wrapped, opts.Styled) + "\n")
+			}
+		}
+	}
+
+	return bw.Flush()
+}
+
+// heading writes the heading text underlined with the specified rune
+func heading(w io.Writer, text string, underline rune, opts RenderOptions) {
+	if opts.Styled {
+		fmt.Fprintf(w, "%s%s%s\n", bold, text, reset)
+	} else {
+		fmt.Fprintln(w, text)
+	}
+
+	fmt.Fprintln(w, strings.Repeat(string(underline), utf8.RuneCountInString(text)))
+}
+
+// highlight replaces the markup of the highlighted text in line with the emphasis markers
+func highlight(line string) string {
+	return emphasisPattern.ReplaceAllStringFunc(line, func(match string) string {
+		before, text, after := emphasis(emphasisPattern.FindStringSubmatch(match))
+		return before + string(emphasisStart) + text + string(emphasisEnd) + after
+	})
+}
+
+// style replaces the emphasis markers in line with the escape codes for bold text, or
+// removes them if the text isn't styled
+func style(line string, styled bool) string {
+	if !styled {
+		return strings.NewReplacer(string(emphasisStart), "", string(emphasisEnd), "").Replace(line)
+	}
+
+	return strings.NewReplacer(string(emphasisStart), bold, string(emphasisEnd), reset).Replace(line)
+}
+
+// wrap splits the line into lines no wider than width, breaking between words. A word
+// that is wider than width is put on a line of its own. A blank line is returned as a
+// single empty line.
+func wrap(line string, width int) []string {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return []string{""}
+	}
+
+	var lines []string
+	var current strings.Builder
+	currentWidth := 0
+
+	for _, word := range words {
+		wordWidth := visibleWidth(word)
+		if currentWidth > 0 && currentWidth+1+wordWidth > width {
+			lines = append(lines, current.String())
+			current.Reset()
+			currentWidth = 0
+		}
+
+		if currentWidth > 0 {
+			current.WriteByte(' ')
+			currentWidth++
+		}
+
+		current.WriteString(word)
+		currentWidth += wordWidth
+	}
+
+	return append(lines, current.String())
+}
+
+// visibleWidth returns the number of runes in s, not including the emphasis markers
+func visibleWidth(s string) int {
+	return utf8.RuneCountInString(s) - strings.Count(s, string(emphasisStart)) - strings.Count(s, string(emphasisEnd))
+}
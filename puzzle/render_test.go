@@ -0,0 +1,116 @@
+package puzzle
+
+import (
+	"bytes"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	for _, test := range []struct {
+		line  string
+		width int
+		want  []string
+	}{
+		{"", 10, []string{""}},
+		{"one two three four", 9, []string{"one two", "three", "four"}},
+		{"a verylongword b", 5, []string{"a", "verylongword", "b"}},
+		{highlight("the answer is *11* here"), 16, []string{"the answer is \x0111\x02", "here"}},
+	} {
+		if got := wrap(test.line, test.width); !slices.Equal(got, test.want) {
+			t.Errorf("wrap Test (%q, %d):\nwant %q\ngot %q\n", test.line, test.width, test.want, got)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	p, err := Parse(strings.NewReader(plainDescription))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, p, RenderOptions{Width: 40}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := `Day 1: Test Puzzle
+==================
+There are two lists of numbers.
+
+For example:
+
+    3   4
+    4   3
+
+    1   3
+Maybe the lists are only off by a small
+amount! Pair up the numbers.
+
+In this example, the total distance is
+11!
+
+What is the total distance between your
+lists?
+
+Part 2
+------
+Here is a larger example:
+
+    RRRR
+    RRII
+It contains:
+
+A region of R plants.
+
+What is the similarity score?
+`
+
+	if buf.String() != want {
+		t.Errorf("Render Test:\nwant %s\ngot %s\n", want, buf.String())
+	}
+
+	p, err = Parse(strings.NewReader(fencedDescription))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	buf.Reset()
+	if err := Render(&buf, p, RenderOptions{Width: 80, Styled: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{bold + "Day 2: Fenced" + reset, "\n    1 2\n    3 4\n\n", "The sum is " + bold + "10" + reset} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Render Test (styled):\nwant %q\ngot %q\n", want, buf.String())
+		}
+	}
+
+	if strings.Contains(buf.String(), "```") {
+		t.Errorf("Render Test (styled):\nwant no fences\ngot %q\n", buf.String())
+	}
+}
+
+func TestRenderMultiplication(t *testing.T) {
+	p, err := Load(Filename(filepath.Join("..", "data"), 2024, 3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Render(&buf, p, RenderOptions{Width: 200}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the asterisks of the multiplications aren't highlighting
+	want := "Adding up the result of each instruction produces 161 (2*4 + 5*5 + 11*8 + 8*5).\n"
+	if !strings.Contains(buf.String(), want) {
+		t.Errorf("Render Multiplication Test:\nwant %q\ngot %q\n", want, buf.String())
+	}
+
+	if got := highlight("The sum is *10*, and (*11*) is more."); got != "The sum is \x0110\x02, and (\x0111\x02) is more." {
+		t.Errorf("Render Multiplication Test (highlight):\nwant %q\ngot %q\n", "The sum is \x0110\x02, and (\x0111\x02) is more.", got)
+	}
+}
@@ -0,0 +1,242 @@
+// search.go indexes the stored puzzle descriptions so they can be searched by their text
+package puzzle
+
+import (
+	"cmp"
+	"io/fs"
+	"math"
+	"path"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/trentnix/aoc2024/fileprocessing"
+)
+
+// snippetWidth is the approximate number of characters in the snippet of a match
+const snippetWidth = 120
+
+// dayPathPattern matches the path of a description stored as data/YYYY/dayN/dayN.md
+var dayPathPattern = regexp.MustCompile(`(\d{4})/day(\d+)/day\d+\.md$`)
+
+// Document is a description in a search index
+type Document struct {
+	Path  string // the path of the markdown file
+	Year  int    // the year of the puzzle, or 0 if it isn't the description of a day
+	Day   int    // the day of the puzzle, or 0 if it isn't the description of a day
+	Title string // the title of the puzzle, if the description has a heading
+	Lines []string
+}
+
+// Match is a document that matches a search
+type Match struct {
+	Document *Document
+	Score    float64 // how well the document matches the terms it contains; higher is better
+	Terms    int     // how many of the terms of the query the document contains
+	Snippet  string  // the text around the best matching line
+	Line     int     // the line number (starting at 1) of the snippet
+}
+
+// Index is a full-text index of puzzle descriptions
+type Index struct {
+	documents []*Document
+	terms     map[string]map[int]int // the number of times each term appears in each document
+	lengths   []int                  // the number of terms in each document
+}
+
+// BuildIndex indexes each markdown file in the directory dir of fsys and its
+// subdirectories. The year and day of the descriptions stored as data/YYYY/dayN/dayN.md
+// are taken from their paths.
+func BuildIndex(fsys fs.FS, dir string) (*Index, error) {
+	index := &Index{terms: make(map[string]map[int]int)}
+
+	err := fs.WalkDir(fsys, dir, func(name string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() || path.Ext(name) != ".md" {
+			return nil
+		}
+
+		file, err := fsys.Open(name)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		lines, err := fileprocessing.Read(file)
+		if err != nil {
+			return err
+		}
+
+		index.add(name, lines)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return index, nil
+}
+
+// Len returns the number of documents in the index
+func (index *Index) Len() int {
+	return len(index.documents)
+}
+
+// add adds the lines of the markdown file at name to the index
+func (index *Index) add(name string, lines []string) {
+	document := &Document{Path: name, Lines: lines}
+
+	if matches := dayPathPattern.FindStringSubmatch(name); matches != nil {
+		document.Year, _ = strconv.Atoi(matches[1])
+		document.Day, _ = strconv.Atoi(matches[2])
+	}
+
+	for _, line := range lines {
+		if matches := titlePattern.FindStringSubmatch(line); matches != nil {
+			document.Title = matches[2]
+			break
+		}
+	}
+
+	id := len(index.documents)
+	index.documents = append(index.documents, document)
+
+	length := 0
+	for _, line := range lines {
+		for _, term := range terms(line) {
+			if index.terms[term] == nil {
+				index.terms[term] = make(map[int]int)
+			}
+
+			index.terms[term][id]++
+			length++
+		}
+	}
+
+	index.lengths = append(index.lengths, length)
+}
+
+// Search returns the documents that contain any of the terms of the query, with the best
+// matches first. A document is scored by the frequency of each term in it, weighted so
+// rare terms count for more than common ones and normalised by the length of the document.
+// Documents that contain every term are ranked ahead of those that don't. At most limit
+// matches are returned, or every match if limit is 0.
+func (index *Index) Search(query string, limit int) []Match {
+	queryTerms := slices.Compact(slices.Sorted(slices.Values(terms(query))))
+	if len(queryTerms) == 0 {
+		return nil
+	}
+
+	scores := make(map[int]float64)
+	found := make(map[int]int)
+
+	for _, term := range queryTerms {
+		postings := index.terms[term]
+		if len(postings) == 0 {
+			continue
+		}
+
+		// the inverse document frequency: terms in fewer documents are worth more
+		idf := math.Log(1 + float64(len(index.documents))/float64(len(postings)))
+		for id, count := range postings {
+			scores[id] += float64(count) * idf / math.Sqrt(float64(index.lengths[id]))
+			found[id]++
+		}
+	}
+
+	matches := make([]Match, 0, len(scores))
+	for id, score := range scores {
+		document := index.documents[id]
+		snippet, line := snippet(document.Lines, queryTerms)
+		matches = append(matches, Match{Document: document, Score: score, Terms: found[id], Snippet: snippet, Line: line})
+	}
+
+	// a document that contains more of the terms is ranked ahead of one that contains fewer
+	slices.SortFunc(matches, func(a, b Match) int {
+		return cmp.Or(cmp.Compare(b.Terms, a.Terms), cmp.Compare(b.Score, a.Score), cmp.Compare(a.Document.Path, b.Document.Path))
+	})
+
+	if limit > 0 && len(matches) > limit {
+		matches = matches[:limit]
+	}
+
+	return matches
+}
+
+// snippet returns the text around the first of the query terms in the line that contains
+// the most of them, and the line number of that line
+func snippet(lines []string, queryTerms []string) (string, int) {
+	best, bestCount := -1, 0
+	for i, line := range lines {
+		count := 0
+		for _, term := range terms(line) {
+			if slices.Contains(queryTerms, term) {
+				count++
+			}
+		}
+
+		if count > bestCount {
+			best, bestCount = i, count
+		}
+	}
+
+	if best < 0 {
+		return "", 0
+	}
+
+	line := strings.TrimSpace(lines[best])
+	if len(line) <= snippetWidth {
+		return line, best + 1
+	}
+
+	// start the snippet a little ahead of the first matching word
+	start := len(line)
+	lower := strings.ToLower(line)
+	for _, term := range queryTerms {
+		if i := strings.Index(lower, term); i >= 0 {
+			start = min(start, i)
+		}
+	}
+
+	start = max(0, min(start-snippetWidth/4, len(line)-snippetWidth))
+	for start > 0 && line[start-1] != ' ' {
+		start--
+	}
+
+	end := min(len(line), start+snippetWidth)
+	for end < len(line) && line[end] != ' ' {
+		end++
+	}
+
+	text := strings.TrimSpace(line[start:end])
+	if start > 0 {
+		text = "..." + text
+	}
+
+	if end < len(line) {
+		text += "..."
+	}
+
+	return text, best + 1
+}
+
+// terms splits text into lowercase words for indexing. A trailing "s" is removed from
+// longer words so that plurals match (e.g. "robots" matches "robot").
+func terms(text string) []string {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for i, word := range words {
+		if len(word) > 3 && strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") {
+			words[i] = strings.TrimSuffix(word, "s")
+		}
+	}
+
+	return words
+}
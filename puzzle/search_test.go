@@ -0,0 +1,78 @@
+package puzzle
+
+import (
+	"os"
+	"testing"
+	"testing/fstest"
+)
+
+func TestSearch(t *testing.T) {
+	fsys := fstest.MapFS{
+		"data/2024/day1/day1.md":   {Data: []byte("--- Day 1: Historian Hysteria ---\nThe Chief Historian is missing.\nFind the lists of location IDs.\n")},
+		"data/2024/day2/day2.md":   {Data: []byte("--- Day 2: Red-Nosed Reports ---\nThe reports are made of levels.\nA report is safe if the levels are increasing.\n")},
+		"data/2024/day3/day3.md":   {Data: []byte("--- Day 3: Mull It Over ---\nThe computer's memory is corrupted.\n")},
+		"data/final.md":            {Data: []byte("The Chief Historian was never missing.\n")},
+		"data/2024/day1/input.txt": {Data: []byte("3   4\n")},
+	}
+
+	index, err := BuildIndex(fsys, "data")
+	if err != nil {
+		t.Fatalf("Search Test: unexpected error %v", err)
+	}
+
+	if index.Len() != 4 {
+		t.Errorf("Search Test: the number of documents:\nwant %v\ngot %v\n", 4, index.Len())
+	}
+
+	matches := index.Search("safe reports", 0)
+	if len(matches) != 1 {
+		t.Fatalf("Search Test: the number of matches:\nwant %v\ngot %v\n", 1, len(matches))
+	}
+
+	match := matches[0]
+	if match.Document.Year != 2024 || match.Document.Day != 2 || match.Document.Title != "Red-Nosed Reports" {
+		t.Errorf("Search Test: the matching document:\nwant %v\ngot %v\n", "2024 day 2 Red-Nosed Reports", *match.Document)
+	}
+
+	if match.Line != 3 || match.Snippet != "A report is safe if the levels are increasing." {
+		t.Errorf("Search Test: the snippet:\nwant %v\ngot %v\n", "line 3", match)
+	}
+
+	// both documents contain every term, so the shorter one ranks first
+	matches = index.Search("missing historian", 0)
+	if len(matches) != 2 || matches[0].Document.Path != "data/final.md" || matches[1].Document.Day != 1 {
+		t.Errorf("Search Test: the ranking:\nwant %v\ngot %v\n", "data/final.md, then day 1", matches)
+	}
+
+	// a document containing every term ranks ahead of one that contains only some
+	matches = index.Search("chief lists", 1)
+	if len(matches) != 1 || matches[0].Document.Day != 1 {
+		t.Errorf("Search Test: the limited ranking:\nwant %v\ngot %v\n", "day 1", matches)
+	}
+
+	if matches := index.Search("north pole", 0); len(matches) != 0 {
+		t.Errorf("Search Test: the unmatched search:\nwant %v\ngot %v\n", 0, len(matches))
+	}
+}
+
+func TestSearchDescriptions(t *testing.T) {
+	index, err := BuildIndex(os.DirFS("../data"), ".")
+	if err != nil {
+		t.Fatalf("Search Test: unexpected error %v", err)
+	}
+
+	matches := index.Search("keypad robots", 1)
+	if len(matches) != 1 || matches[0].Document.Day != 21 {
+		t.Errorf("Search Test: the best match for keypad robots:\nwant %v\ngot %v\n", 21, matches)
+	}
+}
+
+func TestSnippet(t *testing.T) {
+	line := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore et dolore magna aliqua. Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo consequat."
+
+	snippet, number := snippet([]string{"", line}, []string{"veniam"})
+	want := "...magna aliqua. Ut enim ad minim veniam, quis nostrud exercitation ullamco laboris nisi ut aliquip ex ea commodo consequat."
+	if snippet != want || number != 2 {
+		t.Errorf("Snippet Test:\nwant %v (line 2)\ngot %v (line %v)\n", want, snippet, number)
+	}
+}
@@ -0,0 +1,213 @@
+// answers.go compares the answers produced by exercises against the accepted answers stored
+// alongside their input files
+package runner
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/fileprocessing"
+)
+
+// AnswersFileName is the name of the file, in the same directory as an exercise's default
+// input file, that stores the accepted answers of the exercise
+const AnswersFileName = "answers.json"
+
+// Answers are the accepted answers to each part of an exercise, as the text submitted to
+// the Advent of Code site. An empty answer means the answer isn't known.
+type Answers struct {
+	Part1 string `json:"part1,omitempty"`
+	Part2 string `json:"part2,omitempty"`
+}
+
+// CheckStatus describes how the answer to a part compares to the accepted answer
+type CheckStatus string
+
+const (
+	CheckPass    CheckStatus = "pass"    // the answer matches the accepted answer
+	CheckFail    CheckStatus = "fail"    // the answer doesn't match the accepted answer
+	CheckError   CheckStatus = "error"   // the part failed to produce an answer
+	CheckMissing CheckStatus = "missing" // there is no accepted answer to compare against
+)
+
+// Check is the comparison of the answer to a single part of an exercise against the
+// accepted answer
+type Check struct {
+	Name     string // the name of the exercise
+	Part     int
+	Expected string // the accepted answer
+	Got      string // the answer produced by the exercise
+	Status   CheckStatus
+	Err      error // set if the part failed to produce an answer
+}
+
+// Part returns the accepted answer to the specified part and whether it is known
+func (a Answers) Part(part int) (string, bool) {
+	switch part {
+	case 1:
+		return a.Part1, a.Part1 != ""
+	case 2:
+		return a.Part2, a.Part2 != ""
+	}
+
+	return "", false
+}
+
+// SetPart sets the accepted answer to the specified part
+func (a *Answers) SetPart(part int, answer string) {
+	switch part {
+	case 1:
+		a.Part1 = answer
+	case 2:
+		a.Part2 = answer
+	}
+}
+
+// AnswersFile returns the name of the file that stores the accepted answers of the
+// exercise, or "" if the exercise doesn't have a default input file
+func AnswersFile(ex exercise.Exercise) string {
+	if ex.GetFile() == "" {
+		return ""
+	}
+
+	return filepath.Join(filepath.Dir(ex.GetFile()), AnswersFileName)
+}
+
+// LoadAnswers reads the accepted answers from the specified file (or its embedded copy).
+// Empty Answers are returned if the file doesn't exist.
+func LoadAnswers(filename string) (Answers, error) {
+	var answers Answers
+
+	file, err := fileprocessing.Open(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return answers, nil
+	} else if err != nil {
+		return answers, err
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return answers, err
+	}
+
+	if err := json.Unmarshal(data, &answers); err != nil {
+		return answers, fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	return answers, nil
+}
+
+// SaveAnswers writes the accepted answers to the specified file
+func SaveAnswers(filename string, answers Answers) error {
+	data, err := json.MarshalIndent(answers, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
+// CheckReport compares the answers in the report against the accepted answers. A Check is
+// returned for each of the specified parts that has an answer or an accepted answer.
+func CheckReport(report Report, answers Answers, parts ...int) []Check {
+	var checks []Check
+
+	for _, part := range parts {
+		check := Check{Name: report.Name, Part: part}
+
+		expected, known := answers.Part(part)
+		check.Expected = expected
+
+		answer, found := findAnswer(report.Result, part)
+
+		switch {
+		case found && answer.Err != nil:
+			check.Status = CheckError
+			check.Err = answer.Err
+		case !found && report.Status != StatusOK:
+			check.Status = CheckError
+			check.Err = report.Err
+		case !found && !known:
+			// the exercise doesn't have this part (e.g. the last day only has one part)
+			continue
+		case !known:
+			check.Got = answer.String()
+			check.Status = CheckMissing
+		case answer.String() == expected:
+			check.Got = answer.String()
+			check.Status = CheckPass
+		default:
+			check.Got = answer.String()
+			check.Status = CheckFail
+		}
+
+		checks = append(checks, check)
+	}
+
+	return checks
+}
+
+// CheckFailed returns true if any of the checks failed or couldn't produce an answer
+func CheckFailed(checks []Check) bool {
+	for _, check := range checks {
+		if check.Status == CheckFail || check.Status == CheckError {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteChecks writes a table to w with a row for each check and the number of checks with
+// each status below it
+func WriteChecks(w io.Writer, checks []Check) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	counts := make(map[CheckStatus]int)
+
+	fmt.Fprintln(tw, "EXERCISE\tPART\tEXPECTED\tGOT\tSTATUS")
+	for _, check := range checks {
+		got := check.Got
+		if check.Err != nil {
+			got = check.Err.Error()
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\n", check.Name, check.Part, orDash(check.Expected), orDash(got), check.Status)
+		counts[check.Status]++
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "\n%d passed, %d failed, %d errors, %d missing\n", counts[CheckPass], counts[CheckFail], counts[CheckError], counts[CheckMissing])
+	return err
+}
+
+// findAnswer returns the answer to the specified part of the result
+func findAnswer(result exercise.Result, part int) (exercise.Answer, bool) {
+	for _, answer := range result.Answers {
+		if answer.Part == part {
+			return answer, true
+		}
+	}
+
+	return exercise.Answer{}, false
+}
+
+// orDash returns s, or "-" if s is empty
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
@@ -0,0 +1,103 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+func TestLoadSaveAnswers(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), AnswersFileName)
+
+	answers, err := LoadAnswers(filename)
+	if err != nil || answers != (Answers{}) {
+		t.Errorf("LoadAnswers Test (missing file):\nwant %v\ngot %v (%v)\n", Answers{}, answers, err)
+	}
+
+	expected := Answers{Part1: "11", Part2: "bx,cx,dr"}
+	if err := SaveAnswers(filename, expected); err != nil {
+		t.Fatal(err)
+	}
+
+	answers, err = LoadAnswers(filename)
+	if err != nil || answers != expected {
+		t.Errorf("LoadAnswers Test:\nwant %v\ngot %v (%v)\n", expected, answers, err)
+	}
+}
+
+func TestCheckReport(t *testing.T) {
+	report := Report{
+		Name:   "Day 1",
+		Status: StatusError,
+		Result: exercise.Result{Answers: []exercise.Answer{
+			{Part: 1, Value: 11},
+			{Part: 2, Value: -1, Err: errors.New("no solution")},
+		}},
+	}
+
+	checks := CheckReport(report, Answers{Part1: "11", Part2: "31"}, 1, 2)
+	if len(checks) != 2 || checks[0].Status != CheckPass || checks[1].Status != CheckError {
+		t.Errorf("CheckReport Test:\nwant %v, %v\ngot %v\n", CheckPass, CheckError, checks)
+	}
+
+	report = Report{
+		Name:   "Day 25",
+		Status: StatusOK,
+		Result: exercise.Result{Answers: []exercise.Answer{{Part: 1, Value: 3365}}},
+	}
+
+	checks = CheckReport(report, Answers{Part1: "3364"}, 1, 2)
+	if len(checks) != 1 || checks[0].Status != CheckFail || checks[0].Got != "3365" {
+		t.Errorf("CheckReport Test (mismatch):\nwant %v\ngot %v\n", CheckFail, checks)
+	}
+
+	checks = CheckReport(report, Answers{}, 1, 2)
+	if len(checks) != 1 || checks[0].Status != CheckMissing || CheckFailed(checks) {
+		t.Errorf("CheckReport Test (missing):\nwant %v\ngot %v\n", CheckMissing, checks)
+	}
+
+	report = Report{Name: "Day 2", Status: StatusTimeout, Err: ErrTimeout}
+	checks = CheckReport(report, Answers{Part1: "314", Part2: "373"}, 2)
+	if len(checks) != 1 || checks[0].Part != 2 || checks[0].Status != CheckError || !CheckFailed(checks) {
+		t.Errorf("CheckReport Test (timeout):\nwant %v\ngot %v\n", CheckError, checks)
+	}
+}
+
+func TestWriteChecks(t *testing.T) {
+	checks := []Check{
+		{Name: "Day 1", Part: 1, Expected: "11", Got: "11", Status: CheckPass},
+		{Name: "Day 1", Part: 2, Expected: "31", Got: "30", Status: CheckFail},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteChecks(&buf, checks); err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.HasSuffix(buf.String(), "1 passed, 1 failed, 0 errors, 0 missing\n") {
+		t.Errorf("WriteChecks Test:\ngot\n%s\n", buf.String())
+	}
+}
+
+func TestCheckReportUnsolved(t *testing.T) {
+	// Day 4 has no answer (rather than failing to run) when the grid is too small to search
+	registration, found := exercise.Lookup(2024, 4)
+	if !found {
+		t.Fatal("Day 4 isn't registered")
+	}
+
+	report := Run(context.Background(), registration, Options{InputFile: writeInput(t, "XMA", "MAS", "AMX")})
+	if report.Status != StatusError || report.Err != nil || report.Result.Err() == nil {
+		t.Fatalf("Run Test (unsolved):\nwant %v with an unsolved part\ngot %v (%v)\n", StatusError, report.Status, report.Err)
+	}
+
+	checks := CheckReport(report, Answers{Part1: "18", Part2: "9"}, 1, 2)
+	if len(checks) != 2 || checks[0].Status != CheckError || checks[1].Status != CheckError || checks[0].Err == nil {
+		t.Errorf("CheckReport Test (unsolved):\nwant %v, %v\ngot %v\n", CheckError, CheckError, checks)
+	}
+}
@@ -0,0 +1,189 @@
+// bench.go times repeated runs of exercises and compares the timings against a previous run
+// to find regressions
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"runtime"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+// Benchmark contains the statistics of repeated runs of a single part of an exercise
+type Benchmark struct {
+	Name   string        `json:"name"`           // the name of the exercise
+	Part   int           `json:"part"`           // the part of the exercise that was run
+	Runs   int           `json:"runs"`           // how many times the part was run
+	Min    time.Duration `json:"min_ns"`         // the fastest run
+	Median time.Duration `json:"median_ns"`      // the median run
+	P95    time.Duration `json:"p95_ns"`         // the 95th percentile run
+	Allocs uint64        `json:"allocs_per_run"` // the average number of heap allocations per run
+	Bytes  uint64        `json:"bytes_per_run"`  // the average number of bytes allocated per run
+}
+
+// Comparison is a benchmark compared against the benchmark of the same exercise and part
+// from a previous run
+type Comparison struct {
+	Benchmark
+	Baseline   *Benchmark // the benchmark from the previous run, or nil if there isn't one
+	Change     float64    // the relative change of the median (0.1 is 10% slower)
+	Regression bool       // set if the median is slower than the baseline by more than the threshold
+}
+
+// Bench runs the specified part of the exercise using the provided input data the specified
+// number of times and returns the statistics of the runs. Each run is made in the same way
+// as a part is by Run: a panic is reported as an error, and a run that takes longer than the
+// timeout (if it isn't 0) is stopped or abandoned and reported as ErrTimeout. The runs are
+// stopped if any of them fails or the context is done.
+func Bench(ctx context.Context, ex exercise.Exercise, input []string, part int, runs int, timeout time.Duration) (Benchmark, error) {
+	benchmark := Benchmark{Name: ex.GetName(), Part: part, Runs: runs}
+	if runs < 1 {
+		return benchmark, fmt.Errorf("invalid number of runs %d", runs)
+	}
+
+	durations := make([]time.Duration, 0, runs)
+	var before, after runtime.MemStats
+	var allocs, bytes uint64
+
+	for range runs {
+		runCtx, cancel := withTimeout(ctx, timeout)
+
+		runtime.ReadMemStats(&before)
+		result, err := runPart(runCtx, ex, input, part)
+		runtime.ReadMemStats(&after)
+
+		if err != nil && runCtx.Err() != nil {
+			// the run stopped because the context is done, so report why it is done
+			err = context.Cause(runCtx)
+		}
+		cancel()
+
+		if err == nil {
+			err = result.Err()
+		}
+
+		if err != nil {
+			return benchmark, err
+		}
+
+		// the duration recorded by RunParts doesn't include starting the goroutine of the run
+		durations = append(durations, result.Answers[0].Duration)
+		allocs += after.Mallocs - before.Mallocs
+		bytes += after.TotalAlloc - before.TotalAlloc
+	}
+
+	slices.Sort(durations)
+
+	benchmark.Min = durations[0]
+	benchmark.Median = percentile(durations, 50)
+	benchmark.P95 = percentile(durations, 95)
+	benchmark.Allocs = allocs / uint64(runs)
+	benchmark.Bytes = bytes / uint64(runs)
+
+	return benchmark, nil
+}
+
+// Compare compares each benchmark against the baseline benchmark of the same exercise and
+// part. A benchmark is a regression if its median is slower than the baseline median by
+// more than the threshold (0.1 is 10%).
+func Compare(benchmarks []Benchmark, baseline []Benchmark, threshold float64) []Comparison {
+	comparisons := make([]Comparison, 0, len(benchmarks))
+
+	for _, benchmark := range benchmarks {
+		comparison := Comparison{Benchmark: benchmark}
+
+		for i := range baseline {
+			if baseline[i].Name == benchmark.Name && baseline[i].Part == benchmark.Part {
+				comparison.Baseline = &baseline[i]
+				break
+			}
+		}
+
+		if comparison.Baseline != nil && comparison.Baseline.Median > 0 {
+			comparison.Change = float64(benchmark.Median-comparison.Baseline.Median) / float64(comparison.Baseline.Median)
+			comparison.Regression = comparison.Change > threshold
+		}
+
+		comparisons = append(comparisons, comparison)
+	}
+
+	return comparisons
+}
+
+// Regressed returns true if any of the comparisons is a regression
+func Regressed(comparisons []Comparison) bool {
+	for _, comparison := range comparisons {
+		if comparison.Regression {
+			return true
+		}
+	}
+
+	return false
+}
+
+// LoadBenchmarks reads the benchmarks saved by SaveBenchmarks from the specified file
+func LoadBenchmarks(filename string) ([]Benchmark, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var benchmarks []Benchmark
+	if err := json.Unmarshal(data, &benchmarks); err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", filename, err)
+	}
+
+	return benchmarks, nil
+}
+
+// SaveBenchmarks writes the benchmarks to the specified file so a later run can be compared
+// against them
+func SaveBenchmarks(filename string, benchmarks []Benchmark) error {
+	data, err := json.MarshalIndent(benchmarks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(data, '\n'), 0o644)
+}
+
+// WriteBenchmarks writes a table to w with a row for each comparison. The baseline median
+// and the change are only included if there is a baseline.
+func WriteBenchmarks(w io.Writer, comparisons []Comparison) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "EXERCISE\tPART\tRUNS\tMIN\tMEDIAN\tP95\tALLOCS/RUN\tBYTES/RUN\tBASELINE\tCHANGE")
+	for _, c := range comparisons {
+		baseline, change := "-", "-"
+		if c.Baseline != nil {
+			baseline = formatDuration(c.Baseline.Median)
+			change = fmt.Sprintf("%+.1f%%", c.Change*100)
+			if c.Regression {
+				change += " REGRESSION"
+			}
+		}
+
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%d\t%d\t%s\t%s\n", c.Name, c.Part, c.Runs, formatDuration(c.Min), formatDuration(c.Median), formatDuration(c.P95), c.Allocs, c.Bytes, baseline, change)
+	}
+
+	return tw.Flush()
+}
+
+// percentile returns the pth percentile (using the nearest rank) of the sorted durations
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	return sorted[max(rank-1, 0)]
+}
+
+// formatDuration returns the duration rounded to a precision that is readable in a table
+func formatDuration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
@@ -0,0 +1,109 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+func TestBench(t *testing.T) {
+	var sink [][]byte
+	ex := &fakeExercise{name: "alloc", solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		// 10 allocations of 1KiB each
+		for range 10 {
+			sink = append(sink, make([]byte, 1024))
+		}
+
+		return exercise.Answer{Part: part, Value: part}, nil
+	}}
+
+	benchmark, err := Bench(context.Background(), ex, nil, 2, 5, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if benchmark.Runs != 5 || benchmark.Part != 2 || benchmark.Min > benchmark.Median || benchmark.Median > benchmark.P95 {
+		t.Errorf("Bench Test:\ngot %+v\n", benchmark)
+	}
+
+	if benchmark.Allocs < 10 || benchmark.Bytes < 10*1024 {
+		t.Errorf("Bench Test (allocations):\nwant at least %d allocations, %d bytes\ngot %d, %d\n", 10, 10*1024, benchmark.Allocs, benchmark.Bytes)
+	}
+
+	failing := &fakeExercise{name: "error", solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		return exercise.Answer{Part: part}, exercise.ErrInvalidPart
+	}}
+
+	if _, err := Bench(context.Background(), failing, nil, 2, 5, 0); !errors.Is(err, exercise.ErrInvalidPart) {
+		t.Errorf("Bench Test (error):\nwant %v\ngot %v\n", exercise.ErrInvalidPart, err)
+	}
+
+	panicking := &fakeExercise{name: "panic", solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		panic("boom")
+	}}
+
+	if _, err := Bench(context.Background(), panicking, nil, 1, 5, 0); err == nil || !strings.Contains(err.Error(), "panic: boom") {
+		t.Errorf("Bench Test (panic):\nwant panic: boom\ngot %v\n", err)
+	}
+
+	// the part ignores its context, so it is abandoned once the grace period passes
+	release := make(chan struct{})
+	defer close(release)
+
+	hanging := &fakeExercise{name: "hang", solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		<-release
+		return exercise.Answer{Part: part}, nil
+	}}
+
+	if _, err := Bench(context.Background(), hanging, nil, 1, 5, 10*time.Millisecond); !errors.Is(err, ErrTimeout) {
+		t.Errorf("Bench Test (timeout):\nwant %v\ngot %v\n", ErrTimeout, err)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var durations []time.Duration
+	for i := 1; i <= 20; i++ {
+		durations = append(durations, time.Duration(i))
+	}
+
+	if median, p95 := percentile(durations, 50), percentile(durations, 95); median != 10 || p95 != 19 {
+		t.Errorf("percentile Test:\nwant %v, %v\ngot %v, %v\n", 10, 19, median, p95)
+	}
+
+	if p := percentile(durations[:1], 95); p != 1 {
+		t.Errorf("percentile Test (single run):\nwant %v\ngot %v\n", 1, p)
+	}
+}
+
+func TestCompareBenchmarks(t *testing.T) {
+	baseline := []Benchmark{
+		{Name: "Day 1", Part: 1, Median: 100 * time.Millisecond},
+		{Name: "Day 1", Part: 2, Median: 100 * time.Millisecond},
+	}
+
+	benchmarks := []Benchmark{
+		{Name: "Day 1", Part: 1, Median: 105 * time.Millisecond},
+		{Name: "Day 1", Part: 2, Median: 150 * time.Millisecond},
+		{Name: "Day 2", Part: 1, Median: 150 * time.Millisecond},
+	}
+
+	comparisons := Compare(benchmarks, baseline, 0.1)
+	if comparisons[0].Regression || !comparisons[1].Regression || comparisons[2].Baseline != nil || !Regressed(comparisons) {
+		t.Errorf("Compare Test:\ngot %+v\n", comparisons)
+	}
+
+	filename := filepath.Join(t.TempDir(), "bench.json")
+	if err := SaveBenchmarks(filename, benchmarks); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadBenchmarks(filename)
+	if err != nil || len(loaded) != len(benchmarks) || loaded[1] != benchmarks[1] {
+		t.Errorf("LoadBenchmarks Test:\nwant %v\ngot %v (%v)\n", benchmarks, loaded, err)
+	}
+}
@@ -0,0 +1,78 @@
+// json.go writes the reports of exercise runs as a JSON document for scripts and dashboards
+// to consume
+package runner
+
+import (
+	"encoding/json"
+	"io"
+	"time"
+)
+
+// Record is the JSON form of the run of a single part of an exercise
+type Record struct {
+	Exercise string        `json:"exercise"`         // the name of the exercise
+	Year     int           `json:"year,omitempty"`   // the year the exercise is registered under
+	Day      int           `json:"day,omitempty"`    // the day the exercise is registered under
+	Part     int           `json:"part,omitempty"`   // the part; omitted if the exercise failed before a part produced an answer
+	Label    string        `json:"label,omitempty"`  // a description of what the answer represents
+	Answer   any           `json:"answer,omitempty"` // the answer itself
+	Duration time.Duration `json:"duration_ns"`      // how long the part took to run
+	Status   Status        `json:"status"`
+	Error    string        `json:"error,omitempty"` // set if the part could not be solved
+}
+
+// Document is the JSON document written by WriteJSON
+type Document struct {
+	Runs []Record `json:"runs"`
+}
+
+// Records converts the reports into a Record for each answer. A report that failed without
+// an error attributed to one of its answers also gets a Record (without an answer) holding
+// the error, so the failure of every run is included.
+func Records(reports []Report) []Record {
+	records := []Record{}
+
+	for _, report := range reports {
+		for _, answer := range report.Result.Answers {
+			record := Record{
+				Exercise: report.Name,
+				Year:     report.Year,
+				Day:      report.Day,
+				Part:     answer.Part,
+				Label:    answer.Label,
+				Answer:   answer.Value,
+				Duration: answer.Duration,
+				Status:   StatusOK,
+			}
+
+			if answer.Err != nil {
+				record.Answer = nil
+				record.Status = StatusError
+				record.Error = answer.Err.Error()
+			}
+
+			records = append(records, record)
+		}
+
+		if report.Err != nil {
+			records = append(records, Record{
+				Exercise: report.Name,
+				Year:     report.Year,
+				Day:      report.Day,
+				Duration: report.Duration,
+				Status:   report.Status,
+				Error:    report.Err.Error(),
+			})
+		}
+	}
+
+	return records
+}
+
+// WriteJSON writes a Document to w containing the Records of the reports
+func WriteJSON(w io.Writer, reports []Report) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(Document{Runs: Records(reports)})
+}
@@ -0,0 +1,73 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+func TestWriteJSON(t *testing.T) {
+	file := writeInput(t, "1", "2")
+
+	exercises := []exercise.Exercise{
+		&fakeExercise{name: "first", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			if part == 2 {
+				return exercise.Answer{Part: part, Value: -1, Err: errors.New("no solution")}, nil
+			}
+
+			return exercise.Answer{Part: part, Label: "The length", Value: len(input)}, nil
+		}},
+		&fakeExercise{name: "second", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			return exercise.Answer{Part: part}, errors.New("bad input")
+		}},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, RunAll(context.Background(), registrationsOf(exercises...), Options{})); err != nil {
+		t.Fatal(err)
+	}
+
+	var document struct {
+		Runs []map[string]any `json:"runs"`
+	}
+
+	if err := json.Unmarshal(buf.Bytes(), &document); err != nil {
+		t.Fatalf("WriteJSON Test: invalid JSON %v\n%s", err, buf.String())
+	}
+
+	// the year and day are those of the registrations, whatever the exercises are named. JSON
+	// numbers are decoded as float64.
+	expected := []map[string]any{
+		{"exercise": "first", "year": 2024.0, "day": 1.0, "part": 1.0, "label": "The length", "answer": 2.0, "status": "ok"},
+		{"exercise": "first", "year": 2024.0, "day": 1.0, "part": 2.0, "status": "error", "error": "no solution"},
+		{"exercise": "second", "year": 2024.0, "day": 2.0, "status": "error", "error": "bad input"},
+	}
+
+	if len(document.Runs) != len(expected) {
+		t.Fatalf("WriteJSON Test:\nwant %d records\ngot %s\n", len(expected), buf.String())
+	}
+
+	for i, want := range expected {
+		got := document.Runs[i]
+		if _, ok := got["duration_ns"]; !ok {
+			t.Errorf("WriteJSON Test (record %d):\nwant duration_ns\ngot %v\n", i, got)
+		}
+
+		delete(got, "duration_ns")
+		if len(got) != len(want) {
+			t.Errorf("WriteJSON Test (record %d):\nwant %v\ngot %v\n", i, want, got)
+			continue
+		}
+
+		for key, value := range want {
+			if got[key] != value {
+				t.Errorf("WriteJSON Test (record %d):\nwant %v\ngot %v\n", i, want, got)
+				break
+			}
+		}
+	}
+}
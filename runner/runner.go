@@ -0,0 +1,273 @@
+// runner.go runs exercises against their default input files and reports how each run went
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/fileprocessing"
+)
+
+// Status describes how the run of an exercise ended
+type Status string
+
+const (
+	StatusOK      Status = "ok"      // every part was solved
+	StatusError   Status = "error"   // the exercise failed to run or a part could not be solved
+	StatusTimeout Status = "timeout" // the exercise didn't finish before the timeout
+)
+
+// ErrTimeout is returned when an exercise doesn't finish before the timeout
+var ErrTimeout = errors.New("timed out")
+
+// stopGracePeriod is how long an exercise has to stop once its context is done before it
+// is abandoned
+const stopGracePeriod = 250 * time.Millisecond
+
+// Options configures how exercises are run
+type Options struct {
+	Part      int           // the part to run (1 or 2); both parts are run if 0
+	InputFile string        // the input file; the exercise's default input file is used if empty
+	Timeout   time.Duration // how long an exercise may run; there is no limit if 0
+	Workers   int           // how many exercises RunAll runs at once; they are run one at a time if less than 2
+}
+
+// Report describes the run of a single exercise
+type Report struct {
+	Name     string          // the name of the exercise
+	Year     int             // the year the exercise is registered under
+	Day      int             // the day the exercise is registered under
+	Result   exercise.Result // the answers produced by the exercise
+	Err      error           // set if the exercise failed to run or timed out
+	Duration time.Duration   // the wall time of the run, not including reading the input
+	Status   Status
+}
+
+// outcome is what an exercise running in its own goroutine sends back
+type outcome struct {
+	result exercise.Result
+	err    error
+}
+
+// Run runs the registered exercise against the input file in opts (or its default input
+// file) and reports the answers, how long the run took and how it ended.
+//
+// The parts are run one at a time, each in its own goroutine, and the answer of each part
+// is added to the report as soon as it is returned, so a timeout only loses the part that
+// was still running. Each part is given a context that is done when ctx is done or the
+// timeout passes. The solutions that can run for a long time stop when their context is
+// done, and the run is reported as StatusTimeout (or StatusError if ctx was cancelled). A
+// solution that doesn't check its context is abandoned instead: its goroutine keeps running
+// in the background until it finishes or the program exits.
+func Run(ctx context.Context, registration exercise.Registration, opts Options) Report {
+	ex := registration.Exercise
+	report := Report{Name: ex.GetName(), Year: registration.Year, Day: registration.Day, Result: exercise.Result{Name: ex.GetName()}}
+
+	input, err := ReadInput(ex, opts.InputFile)
+	if err != nil {
+		return finish(report, err)
+	}
+
+	ctx, cancel := withTimeout(ctx, opts.Timeout)
+	defer cancel()
+
+	parts := []int{1, 2}
+	if opts.Part != 0 {
+		parts = []int{opts.Part}
+	}
+
+	start := time.Now()
+
+	for _, part := range parts {
+		var result exercise.Result
+		result, err = runPart(ctx, ex, input, part)
+		if opts.Part == 0 && part > 1 && errors.Is(err, exercise.ErrInvalidPart) {
+			// the exercise only has the parts before this one
+			err = nil
+			break
+		}
+
+		report.Result.Answers = append(report.Result.Answers, result.Answers...)
+		if err != nil {
+			break
+		}
+	}
+
+	report.Duration = time.Since(start)
+
+	if err != nil && ctx.Err() != nil {
+		// the exercise stopped because the context is done, so report why it is done
+		err = context.Cause(ctx)
+	}
+
+	return finish(report, err)
+}
+
+// withTimeout returns a context that is done when ctx is done or the timeout passes, with
+// the cause ErrTimeout in the latter case. There is no limit if the timeout is 0.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeoutCause(ctx, timeout, fmt.Errorf("%w after %v", ErrTimeout, timeout))
+}
+
+// runPart runs the specified part of the exercise in its own goroutine and returns its
+// answer, or the context's error if the part doesn't stop once the context is done
+func runPart(ctx context.Context, ex exercise.Exercise, input []string, part int) (exercise.Result, error) {
+	done := make(chan outcome, 1)
+
+	go func() {
+		defer func() {
+			// a panic in one exercise shouldn't take down the rest of the run
+			if r := recover(); r != nil {
+				done <- outcome{err: fmt.Errorf("panic: %v", r)}
+			}
+		}()
+
+		result, err := exercise.RunParts(ctx, ex, input, part)
+		done <- outcome{result: result, err: err}
+	}()
+
+	select {
+	case o := <-done:
+		return o.result, o.err
+	case <-ctx.Done():
+		// give a solution that checks its context a moment to stop and return the answer
+		// it has, before abandoning it
+		select {
+		case o := <-done:
+			return o.result, o.err
+		case <-time.After(stopGracePeriod):
+			return exercise.Result{}, ctx.Err()
+		}
+	}
+}
+
+// RunAll runs each of the registered exercises and returns a report for each, in the same
+// order as the registrations. Up to opts.Workers exercises are run at once.
+func RunAll(ctx context.Context, registrations []exercise.Registration, opts Options) []Report {
+	reports := make([]Report, len(registrations))
+
+	workers := max(1, min(opts.Workers, len(registrations)))
+
+	// each worker takes the index of the next exercise to run and writes its report to
+	// that index, so the reports stay in order no matter which finishes first
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				reports[i] = Run(ctx, registrations[i], opts)
+			}
+		}()
+	}
+
+	for i := range registrations {
+		indexes <- i
+	}
+	close(indexes)
+
+	wg.Wait()
+
+	return reports
+}
+
+// Failed returns true if any of the reports doesn't have StatusOK
+func Failed(reports []Report) bool {
+	for _, report := range reports {
+		if report.Status != StatusOK {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WriteSummary writes a table to w with a row for each report containing the answers, the
+// wall time and the status of the run. The errors of the runs that failed are listed
+// below the table.
+func WriteSummary(w io.Writer, reports []Report) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "EXERCISE\tPART 1\tPART 2\tTIME\tSTATUS")
+	for _, report := range reports {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%v\t%s\n", report.Name, answerText(report.Result, 1), answerText(report.Result, 2), formatDuration(report.Duration), report.Status)
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	for _, report := range reports {
+		if report.Status == StatusOK {
+			continue
+		}
+
+		err := report.Err
+		if err == nil {
+			err = report.Result.Err()
+		}
+
+		if _, err := fmt.Fprintf(w, "%s - %v\n", report.Name, err); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ReadInput reads the contents of the specified input file, or the default input file of
+// the exercise if inputFile is empty
+func ReadInput(ex exercise.Exercise, inputFile string) ([]string, error) {
+	if inputFile == "" {
+		inputFile = ex.GetFile()
+	}
+
+	if inputFile == "" {
+		return nil, fmt.Errorf("a default input file is not specified")
+	}
+
+	input, err := fileprocessing.ReadFile(inputFile)
+	if err != nil {
+		return nil, fmt.Errorf("there was an error trying to read the input file %s: %w", inputFile, err)
+	}
+
+	return input, nil
+}
+
+// finish sets the error and status of the report
+func finish(report Report, err error) Report {
+	report.Err = err
+
+	switch {
+	case errors.Is(err, ErrTimeout):
+		report.Status = StatusTimeout
+	case err != nil || report.Result.Err() != nil:
+		report.Status = StatusError
+	default:
+		report.Status = StatusOK
+	}
+
+	return report
+}
+
+// answerText returns the answer to the specified part of the result as text, or "-" if
+// there isn't an answer for that part
+func answerText(result exercise.Result, part int) string {
+	answer, found := findAnswer(result, part)
+	if !found || answer.Err != nil {
+		return "-"
+	}
+
+	return orDash(answer.String())
+}
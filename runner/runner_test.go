@@ -0,0 +1,234 @@
+package runner
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/trentnix/aoc2024/exercise"
+)
+
+// fakeExercise is an Exercise whose parts are solved by the solve function
+type fakeExercise struct {
+	name  string
+	file  string
+	solve func(ctx context.Context, input []string, part int) (exercise.Answer, error)
+}
+
+func (f *fakeExercise) GetName() string {
+	return f.name
+}
+
+func (f *fakeExercise) GetFile() string {
+	return f.file
+}
+
+func (f *fakeExercise) RunPart(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+	return f.solve(ctx, input, part)
+}
+
+func (f *fakeExercise) RunFromInput(ctx context.Context, input []string) (exercise.Result, error) {
+	return exercise.RunParts(ctx, f, input, 1, 2)
+}
+
+func (f *fakeExercise) Run(ctx context.Context) (exercise.Result, error) {
+	input, err := ReadInput(f, "")
+	if err != nil {
+		return exercise.Result{Name: f.name}, err
+	}
+
+	return f.RunFromInput(ctx, input)
+}
+
+// registrationsOf returns a registration of each exercise, as days 1, 2, ... of 2024
+func registrationsOf(exercises ...exercise.Exercise) []exercise.Registration {
+	registrations := make([]exercise.Registration, len(exercises))
+	for i, ex := range exercises {
+		registrations[i] = exercise.Registration{Info: exercise.Info{Year: 2024, Day: i + 1}, Exercise: ex}
+	}
+
+	return registrations
+}
+
+// writeInput writes the lines to an input file and returns the name of the file
+func writeInput(t *testing.T, lines ...string) string {
+	filename := filepath.Join(t.TempDir(), "input.txt")
+	if err := os.WriteFile(filename, []byte(strings.Join(lines, "\n")), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	return filename
+}
+
+func TestRunAll(t *testing.T) {
+	file := writeInput(t, "1", "2")
+
+	exercises := []exercise.Exercise{
+		&fakeExercise{name: "ok", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			return exercise.Answer{Part: part, Value: len(input) * part}, nil
+		}},
+		&fakeExercise{name: "error", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			return exercise.Answer{Part: part}, errors.New("bad input")
+		}},
+		&fakeExercise{name: "unsolved", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			return exercise.Answer{Part: part, Value: -1, Err: errors.New("no solution")}, nil
+		}},
+		&fakeExercise{name: "slow", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			time.Sleep(time.Second)
+			return exercise.Answer{Part: part}, nil
+		}},
+		&fakeExercise{name: "panic", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			panic("boom")
+		}},
+		&fakeExercise{name: "missing", file: filepath.Join(t.TempDir(), "missing.txt")},
+	}
+
+	reports := RunAll(context.Background(), registrationsOf(exercises...), Options{Timeout: 50 * time.Millisecond})
+
+	expectedStatuses := []Status{StatusOK, StatusError, StatusError, StatusTimeout, StatusError, StatusError}
+	for i, report := range reports {
+		if report.Status != expectedStatuses[i] {
+			t.Errorf("RunAll Test (%s):\nwant %v\ngot %v (%v)\n", report.Name, expectedStatuses[i], report.Status, report.Err)
+		}
+	}
+
+	if answer := answerText(reports[0].Result, 2); answer != "4" {
+		t.Errorf("RunAll Test (ok part 2):\nwant %v\ngot %v\n", "4", answer)
+	}
+
+	if !Failed(reports) || Failed(reports[:1]) {
+		t.Errorf("Failed Test:\nwant %v, %v\ngot %v, %v\n", true, false, Failed(reports), Failed(reports[:1]))
+	}
+}
+
+func TestRunSinglePart(t *testing.T) {
+	file := writeInput(t, "1", "2", "3")
+
+	ex := &fakeExercise{name: "ok", solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		return exercise.Answer{Part: part, Value: len(input) * part}, nil
+	}}
+
+	report := Run(context.Background(), registrationsOf(ex)[0], Options{Part: 2, InputFile: file})
+	if report.Status != StatusOK || len(report.Result.Answers) != 1 || report.Result.Answers[0].String() != "6" {
+		t.Errorf("Run Test (part 2):\nwant %v\ngot %v (%v)\n", "6", report.Result.Answers, report.Err)
+	}
+}
+
+func TestWriteSummary(t *testing.T) {
+	reports := []Report{
+		{Name: "Day 1", Status: StatusOK, Result: exercise.Result{Answers: []exercise.Answer{{Part: 1, Value: 11}, {Part: 2, Value: 31}}}},
+		{Name: "Day 2", Status: StatusTimeout, Err: ErrTimeout},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteSummary(&buf, reports); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || !strings.Contains(lines[1], "11") || !strings.Contains(lines[1], "31") || !strings.HasSuffix(lines[2], "timeout") || lines[3] != "Day 2 - timed out" {
+		t.Errorf("WriteSummary Test:\ngot\n%s\n", buf.String())
+	}
+}
+
+func TestRunAllWorkers(t *testing.T) {
+	file := writeInput(t, "1")
+
+	// the earlier exercises are slower, so they finish after the later ones
+	var exercises []exercise.Exercise
+	for i := range 4 {
+		delay := time.Duration(4-i) * 20 * time.Millisecond
+		exercises = append(exercises, &fakeExercise{name: string(rune('A' + i)), file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+			time.Sleep(delay)
+			return exercise.Answer{Part: part, Value: i}, nil
+		}})
+	}
+
+	start := time.Now()
+	reports := RunAll(context.Background(), registrationsOf(exercises...), Options{Part: 1, Workers: 4})
+	elapsed := time.Since(start)
+
+	for i, report := range reports {
+		if report.Name != exercises[i].GetName() || answerText(report.Result, 1) != fmt.Sprint(i) {
+			t.Errorf("RunAll Workers Test (order):\nwant %v\ngot %v (%v)\n", exercises[i].GetName(), report.Name, report.Result.Answers)
+		}
+	}
+
+	// run one at a time, the exercises take 200ms
+	if elapsed >= 200*time.Millisecond {
+		t.Errorf("RunAll Workers Test (elapsed):\nwant less than %v\ngot %v\n", 200*time.Millisecond, elapsed)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	file := writeInput(t, "1")
+
+	// the exercise stops as soon as its context is done
+	ex := &fakeExercise{name: "forever", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		<-ctx.Done()
+		return exercise.Answer{Part: part}, ctx.Err()
+	}}
+
+	report := Run(context.Background(), registrationsOf(ex)[0], Options{Timeout: 50 * time.Millisecond})
+	if report.Status != StatusTimeout || !errors.Is(report.Err, ErrTimeout) {
+		t.Errorf("Run Timeout Test:\nwant %v\ngot %v (%v)\n", StatusTimeout, report.Status, report.Err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	report = Run(ctx, registrationsOf(ex)[0], Options{Timeout: time.Minute})
+	if report.Status != StatusError || !errors.Is(report.Err, context.Canceled) {
+		t.Errorf("Run Cancelled Test:\nwant %v\ngot %v (%v)\n", StatusError, report.Status, report.Err)
+	}
+}
+
+func TestRunTimeoutKeepsSolvedParts(t *testing.T) {
+	file := writeInput(t, "1", "2")
+
+	// part 1 is solved straight away, and part 2 doesn't check its context
+	ex := &fakeExercise{name: "slow part 2", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		if part == 2 {
+			time.Sleep(time.Second)
+		}
+
+		return exercise.Answer{Part: part, Value: len(input) * part}, nil
+	}}
+
+	report := Run(context.Background(), registrationsOf(ex)[0], Options{Timeout: 50 * time.Millisecond})
+	if report.Status != StatusTimeout {
+		t.Errorf("Run Timeout (status) Test:\nwant %v\ngot %v (%v)\n", StatusTimeout, report.Status, report.Err)
+	}
+
+	if got := answerText(report.Result, 1); got != "2" {
+		t.Errorf("Run Timeout (part 1) Test:\nwant %v\ngot %v\n", "2", got)
+	}
+
+	if got := answerText(report.Result, 2); got != "-" {
+		t.Errorf("Run Timeout (part 2) Test:\nwant %v\ngot %v\n", "-", got)
+	}
+}
+
+func TestRunSinglePartExercise(t *testing.T) {
+	file := writeInput(t, "1")
+
+	// like Day 25, the exercise doesn't have a part 2
+	ex := &fakeExercise{name: "one part", file: file, solve: func(ctx context.Context, input []string, part int) (exercise.Answer, error) {
+		if part != 1 {
+			return exercise.Answer{Part: part}, fmt.Errorf("%w: %d", exercise.ErrInvalidPart, part)
+		}
+
+		return exercise.Answer{Part: part, Value: 1}, nil
+	}}
+
+	report := Run(context.Background(), registrationsOf(ex)[0], Options{})
+	if report.Status != StatusOK || len(report.Result.Answers) != 1 {
+		t.Errorf("Run Single Part Exercise Test:\nwant %v with 1 answer\ngot %v with %v (%v)\n", StatusOK, report.Status, report.Result.Answers, report.Err)
+	}
+}
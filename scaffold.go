@@ -0,0 +1,327 @@
+// scaffold.go generates the files of a new day from the DayX template in exercise/dayX.go
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/trentnix/aoc2024/puzzle"
+)
+
+// dayTemplate is the source of exercise/dayX.go, the template for a new day
+//
+//go:embed exercise/dayX.go
+var dayTemplate string
+
+// testTemplate is the template for the tests of a new day. The placeholders are replaced
+// in the same way as they are in dayTemplate, and EXAMPLE1, EXAMPLE2, ANSWER1 and ANSWER2
+// are replaced with the examples and answers found in the puzzle description.
+const testTemplate = `package exercise
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDayXPart1(t *testing.T) {
+	input := []string{
+EXAMPLE1	}
+
+	expectedValue := ANSWER1
+	if len(input) == 0 || expectedValue == "" {
+		t.Skip("the example input and its answer have not been added yet")
+	}
+
+	dX := DayX{}
+	answer, err := dX.RunPart(context.Background(), input, 1)
+	if err != nil {
+		t.Fatalf("Day X - Part 1 Test: unexpected error %v", err)
+	}
+
+	if answer.String() != expectedValue {
+		t.Errorf("Day X - Part 1 Test:\nwant %v\ngot %v\n", expectedValue, answer)
+	}
+}
+
+func TestDayXPart2(t *testing.T) {
+	input := []string{
+EXAMPLE2	}
+
+	expectedValue := ANSWER2
+	if len(input) == 0 || expectedValue == "" {
+		t.Skip("the example input and its answer have not been added yet")
+	}
+
+	dX := DayX{}
+	answer, err := dX.RunPart(context.Background(), input, 2)
+	if err != nil {
+		t.Fatalf("Day X - Part 2 Test: unexpected error %v", err)
+	}
+
+	if answer.String() != expectedValue {
+		t.Errorf("Day X - Part 2 Test:\nwant %v\ngot %v\n", expectedValue, answer)
+	}
+}
+`
+
+// registrationTemplate registers the new day. It is inserted ahead of the first method of
+// the template and its placeholders are replaced along with the rest of the template.
+const registrationTemplate = `// init registers the Day X exercise
+func init() {
+	RegisterExercise(Info{Year: YEAR, Day: X, Title: TITLE, File: "data/YEAR/dayX/input.txt"}, func(name, file string) Exercise {
+		return &DayX{name: name, file: file}
+	})
+}
+
+`
+
+// exercisePackageYear is the year whose days are implemented in the exercise package. The
+// days of any other year are generated into a package of their own, exercise/yYYYY.
+const exercisePackageYear = 2024
+
+// the import paths of the exercise package and of the packages of the other years
+const (
+	exerciseImportPath = "github.com/trentnix/aoc2024/exercise"
+	yearImportPath     = exerciseImportPath + "/y%d"
+)
+
+// scaffold describes the files generated for a new day
+type scaffold struct {
+	Source    string // exercise/dayN.go, or exercise/yYYYY/dayN.go for another year
+	Test      string // exercise/dayN_test.go, or exercise/yYYYY/dayN_test.go
+	InputFile string // data/YYYY/dayN/input.txt
+	Main      string // main.go, if the blank import of a new package was added to it
+}
+
+// newDay generates the implementation, tests and input file of the specified day in the
+// repository at root. The files are generated from the DayX template with the day number
+// substituted, and the implementation registers itself with the year, day and title. If
+// the puzzle description (data/YYYY/dayN/dayN.md) has already been saved, the tests are
+// filled in with its examples and the title defaults to its title. An error is returned
+// without writing anything if any of the files already exist.
+//
+// The days of exercisePackageYear are generated into the exercise package. The days of any
+// other year are generated into the package exercise/yYYYY, with the identifiers of the
+// exercise package qualified, and main.go is given a blank import of the package (if it
+// doesn't have one already) so the days register themselves.
+func newDay(root string, year, day int, title string) (scaffold, error) {
+	dir, pkg := filepath.Join(root, "exercise"), "exercise"
+	if year != exercisePackageYear {
+		pkg = fmt.Sprintf("y%d", year)
+		dir = filepath.Join(dir, pkg)
+	}
+
+	files := scaffold{
+		Source:    filepath.Join(dir, fmt.Sprintf("day%d.go", day)),
+		Test:      filepath.Join(dir, fmt.Sprintf("day%d_test.go", day)),
+		InputFile: filepath.Join(root, "data", fmt.Sprint(year), fmt.Sprintf("day%d", day), "input.txt"),
+	}
+
+	for _, filename := range []string{files.Source, files.Test, files.InputFile} {
+		if _, err := os.Stat(filename); err == nil {
+			return files, fmt.Errorf("%s already exists", filename)
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return files, err
+		}
+	}
+
+	// the description is optional, since it may not have been saved yet
+	description, err := puzzle.Load(puzzle.Filename(filepath.Join(root, "data"), year, day))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return files, err
+	}
+
+	if title == "" && description != nil {
+		title = description.Title
+	}
+
+	index := strings.Index(dayTemplate, "// GetName returns")
+	if index < 0 {
+		return files, fmt.Errorf("the DayX template doesn't have a GetName method to register the day ahead of")
+	}
+
+	source := dayTemplate[:index] + registrationTemplate + dayTemplate[index:]
+	source = strings.Replace(source, "for the TBD day of the Advent of Code 2024", fmt.Sprintf("for day %d of the Advent of Code %d", day, year), 1)
+
+	replacer := strings.NewReplacer(
+		"dayX", fmt.Sprintf("day%d", day),
+		"DayX", fmt.Sprintf("Day%d", day),
+		"Day X", fmt.Sprintf("Day %d", day),
+		"dX", fmt.Sprintf("d%d", day),
+		"Day: X", fmt.Sprintf("Day: %d", day),
+		"YEAR", fmt.Sprint(year),
+		"TITLE", fmt.Sprintf("%q", title),
+	)
+
+	generated := map[string]string{
+		files.Source: replacer.Replace(source),
+		files.Test:   exampleReplacer(description).Replace(replacer.Replace(testTemplate)),
+	}
+
+	var mainSource []byte
+	if pkg != "exercise" {
+		for filename, source := range generated {
+			if generated[filename], err = qualifyExercise(source, pkg, filename == files.Source); err != nil {
+				return files, fmt.Errorf("there was an error trying to generate %s: %w", filename, err)
+			}
+		}
+
+		if mainSource, err = addBlankImport(filepath.Join(root, "main.go"), fmt.Sprintf(yearImportPath, year)); err != nil {
+			return files, err
+		}
+	}
+
+	for filename, source := range generated {
+		formatted, err := format.Source([]byte(source))
+		if err != nil {
+			return files, fmt.Errorf("there was an error trying to format %s: %w", filename, err)
+		}
+
+		generated[filename] = string(formatted)
+	}
+
+	for _, dir := range []string{filepath.Dir(files.InputFile), filepath.Dir(files.Source)} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return files, err
+		}
+	}
+
+	if err := os.WriteFile(files.InputFile, nil, 0o644); err != nil {
+		return files, err
+	}
+
+	for _, filename := range []string{files.Source, files.Test} {
+		if err := os.WriteFile(filename, []byte(generated[filename]), 0o644); err != nil {
+			return files, err
+		}
+	}
+
+	if mainSource != nil {
+		files.Main = filepath.Join(root, "main.go")
+		if err := os.WriteFile(files.Main, mainSource, 0o644); err != nil {
+			return files, err
+		}
+	}
+
+	return files, nil
+}
+
+// insertion is text to insert into a source at an offset
+type insertion struct {
+	offset int
+	text   string
+}
+
+// qualifyExercise moves the generated source of a day from the exercise package to the
+// package pkg. If qualify is true, the identifiers the source uses from the exercise package
+// (such as RegisterExercise, Answer and RunParts) are qualified with the package name and the
+// package is imported.
+func qualifyExercise(source, pkg string, qualify bool) (string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", source, 0)
+	if err != nil {
+		return "", err
+	}
+
+	var insertions []insertion
+	if qualify {
+		// an identifier that isn't declared in the file is either predeclared (int, nil,
+		// etc., none of which are exported) or one of the exercise package's
+		for _, ident := range file.Unresolved {
+			if ident.IsExported() {
+				insertions = append(insertions, insertion{fset.Position(ident.Pos()).Offset, "exercise."})
+			}
+		}
+
+		if len(file.Imports) == 0 {
+			return "", fmt.Errorf("the DayX template doesn't import any packages")
+		}
+
+		end := fset.Position(file.Imports[len(file.Imports)-1].End()).Offset
+		insertions = append(insertions, insertion{end, fmt.Sprintf("\n\t%q", exerciseImportPath)})
+	}
+
+	// insert from the end of the source, so the offsets of the remaining insertions (and of
+	// the package name) don't move
+	slices.SortFunc(insertions, func(a, b insertion) int {
+		return b.offset - a.offset
+	})
+
+	for _, insertion := range insertions {
+		source = source[:insertion.offset] + insertion.text + source[insertion.offset:]
+	}
+
+	start, end := fset.Position(file.Name.Pos()).Offset, fset.Position(file.Name.End()).Offset
+	return source[:start] + pkg + source[end:], nil
+}
+
+// addBlankImport returns the source of the Go file with a blank import of the package at
+// importPath added, or nil if the file already imports it
+func addBlankImport(filename, importPath string) ([]byte, error) {
+	source, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("there was an error trying to read %s: %w", filename, err)
+	}
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, source, parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.ContainsFunc(file.Imports, func(spec *ast.ImportSpec) bool {
+		return spec.Path.Value == fmt.Sprintf("%q", importPath)
+	}) {
+		return nil, nil
+	}
+
+	if len(file.Imports) == 0 {
+		return nil, fmt.Errorf("%s doesn't import any packages", filename)
+	}
+
+	end := fset.Position(file.Imports[len(file.Imports)-1].End()).Offset
+	updated := fmt.Sprintf("%s\n\t_ %q%s", source[:end], importPath, source[end:])
+
+	return format.Source([]byte(updated))
+}
+
+// exampleReplacer returns a replacer for the example placeholders of testTemplate. The
+// placeholders are replaced with the first example and the answer of each part of the
+// description, or left empty if there isn't a description.
+func exampleReplacer(description *puzzle.Puzzle) *strings.Replacer {
+	var oldnew []string
+
+	for _, part := range []int{1, 2} {
+		var lines strings.Builder
+		answer := ""
+
+		if description != nil {
+			if example, found := description.Example(part); found {
+				for _, line := range example.Lines {
+					fmt.Fprintf(&lines, "\t\t%q,\n", line)
+				}
+			}
+
+			if found, ok := description.Part(part); ok {
+				answer = found.Answer
+			}
+		}
+
+		if lines.Len() == 0 {
+			lines.WriteString("\t\t// the example input from the puzzle description\n")
+		}
+
+		oldnew = append(oldnew, fmt.Sprintf("EXAMPLE%d", part), lines.String(), fmt.Sprintf("ANSWER%d", part), fmt.Sprintf("%q", answer))
+	}
+
+	return strings.NewReplacer(oldnew...)
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewDay(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "exercise"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := newDay(root, 2024, 26, "Test Title")
+	if err != nil {
+		t.Fatalf("newDay Test: unexpected error %v", err)
+	}
+
+	for _, filename := range []string{files.Source, files.Test} {
+		source, err := os.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := parser.ParseFile(token.NewFileSet(), filename, source, 0); err != nil {
+			t.Errorf("newDay Test (%s):\nwant valid Go\ngot %v\n", filename, err)
+		}
+
+		if strings.Contains(string(source), "DayX") || strings.Contains(string(source), "Day X") {
+			t.Errorf("newDay Test (%s):\nwant no DayX placeholders\ngot %s\n", filename, source)
+		}
+	}
+
+	source, _ := os.ReadFile(files.Source)
+	registration := `RegisterExercise(Info{Year: 2024, Day: 26, Title: "Test Title", File: "data/2024/day26/input.txt"}`
+	if !strings.Contains(string(source), registration) || !strings.Contains(string(source), "&Day26{name: name, file: file}") {
+		t.Errorf("newDay Test (registration):\nwant %s\ngot %s\n", registration, source)
+	}
+
+	if _, err := os.Stat(files.InputFile); err != nil {
+		t.Errorf("newDay Test (input file):\nwant %s\ngot %v\n", files.InputFile, err)
+	}
+
+	if _, err := newDay(root, 2024, 26, "Test Title"); err == nil {
+		t.Errorf("newDay Test (existing):\nwant an error\ngot %v\n", err)
+	}
+}
+
+func TestNewDayWithDescription(t *testing.T) {
+	root := t.TempDir()
+	dir := filepath.Join(root, "data", "2024", "day27")
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.Mkdir(filepath.Join(root, "exercise"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	description := "--- Day 27: Test Title ---\nFor example:\n\n1 2\n3 4\nThe sum of the example is *10*.\n\nWhat is the sum?\n"
+	if err := os.WriteFile(filepath.Join(dir, "day27.md"), []byte(description), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := newDay(root, 2024, 27, "")
+	if err != nil {
+		t.Fatalf("newDay Test: unexpected error %v", err)
+	}
+
+	source, _ := os.ReadFile(files.Source)
+	if !strings.Contains(string(source), `Title: "Test Title"`) {
+		t.Errorf("newDay Test (title):\nwant %q\ngot %s\n", "Test Title", source)
+	}
+
+	test, _ := os.ReadFile(files.Test)
+	for _, want := range []string{"\"1 2\",\n\t\t\"3 4\",\n\t}", `expectedValue := "10"`, `expectedValue := ""`} {
+		if !strings.Contains(string(test), want) {
+			t.Errorf("newDay Test (example):\nwant %q\ngot %s\n", want, test)
+		}
+	}
+}
+
+func TestNewDayOtherYear(t *testing.T) {
+	root := t.TempDir()
+	if err := os.Mkdir(filepath.Join(root, "exercise"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	mainSource := "package main\n\nimport (\n\t\"fmt\"\n\n\t\"github.com/trentnix/aoc2024/exercise\"\n)\n"
+	if err := os.WriteFile(filepath.Join(root, "main.go"), []byte(mainSource), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := newDay(root, 2023, 5, "Test Title")
+	if err != nil {
+		t.Fatalf("newDay Test: unexpected error %v", err)
+	}
+
+	if want := filepath.Join(root, "exercise", "y2023", "day5.go"); files.Source != want {
+		t.Errorf("newDay Test (source):\nwant %v\ngot %v\n", want, files.Source)
+	}
+
+	source, _ := os.ReadFile(files.Source)
+	for _, want := range []string{"package y2023", `"github.com/trentnix/aoc2024/exercise"`, "exercise.RegisterExercise(exercise.Info{Year: 2023, Day: 5", "(exercise.Answer, error)", "exercise.RunParts(ctx, d, input, 1, 2)"} {
+		if !strings.Contains(string(source), want) {
+			t.Errorf("newDay Test (package):\nwant %s\ngot %s\n", want, source)
+		}
+	}
+
+	test, _ := os.ReadFile(files.Test)
+	if !strings.HasPrefix(string(test), "package y2023\n") || strings.Contains(string(test), "exercise.") {
+		t.Errorf("newDay Test (test package):\nwant package y2023\ngot %s\n", test)
+	}
+
+	updated, _ := os.ReadFile(files.Main)
+	blankImport := `_ "github.com/trentnix/aoc2024/exercise/y2023"`
+	if strings.Count(string(updated), blankImport) != 1 {
+		t.Errorf("newDay Test (main.go):\nwant %s\ngot %s\n", blankImport, updated)
+	}
+
+	// the package is already imported for the next day of the year
+	files, err = newDay(root, 2023, 6, "")
+	if err != nil || files.Main != "" {
+		t.Errorf("newDay Test (second day):\nwant main.go unchanged\ngot %q (%v)\n", files.Main, err)
+	}
+}
@@ -0,0 +1,42 @@
+// search.go searches the puzzle descriptions stored in the data directory
+package main
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/fileprocessing"
+	"github.com/trentnix/aoc2024/puzzle"
+)
+
+// dataDir is the directory the puzzle descriptions are stored in, on disk or embedded in
+// the binary
+const dataDir = "data"
+
+// searchPuzzles searches the puzzle descriptions for the query and writes up to limit
+// matches to w, each with a snippet of the text that matched. The matches are returned.
+func searchPuzzles(w io.Writer, registrations []exercise.Registration, query string, limit int) ([]puzzle.Match, error) {
+	index, err := puzzle.BuildIndex(fileprocessing.FS(), dataDir)
+	if err != nil {
+		return nil, fmt.Errorf("there was an error trying to index the puzzle descriptions: %w", err)
+	}
+
+	matches := index.Search(query, limit)
+	if len(matches) == 0 {
+		fmt.Fprintf(w, "No puzzle descriptions match %q\n", query)
+		return nil, nil
+	}
+
+	for i, match := range matches {
+		name := match.Document.Path
+		if registration, err := findExercise(registrations, match.Document.Year, match.Document.Day); err == nil {
+			name = fmt.Sprintf("%s - %s", registration.Name(), registration.Title)
+		}
+
+		fmt.Fprintf(w, "%2d. %s (%s:%d)\n", i+1, name, match.Document.Path, match.Line)
+		fmt.Fprintf(w, "    %s\n", match.Snippet)
+	}
+
+	return matches, nil
+}
@@ -0,0 +1,95 @@
+// show.go renders the puzzle descriptions stored alongside the input files in the terminal
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/puzzle"
+)
+
+// defaultPager is the pager used if $PAGER isn't set. -R shows the bold text instead of
+// the escape codes.
+const defaultPager = "less -R"
+
+// showPuzzle writes the puzzle description of the exercise to w, wrapped to width (or the
+// width of the terminal if width is 0). If paged is set, the description is shown in a
+// pager instead, when one can be started.
+func showPuzzle(w io.Writer, registration exercise.Registration, width int, paged bool) error {
+	if registration.File == "" {
+		return fmt.Errorf("%s - a default input file is not specified, so the description can't be found", registration.Name())
+	}
+
+	filename := filepath.Join(filepath.Dir(registration.File), fmt.Sprintf("day%d.md", registration.Day))
+	p, err := puzzle.Load(filename)
+	if err != nil {
+		return fmt.Errorf("there was an error trying to read the puzzle description %s: %w", filename, err)
+	}
+
+	if p.Title == "" {
+		// the description doesn't have a heading, so use the title it was registered with
+		p.Day, p.Title = registration.Day, registration.Title
+	}
+
+	if width <= 0 {
+		width = terminalWidth()
+	}
+
+	opts := puzzle.RenderOptions{Width: width, Styled: w == io.Writer(os.Stdout) && isTerminal(os.Stdout)}
+	if !paged {
+		return puzzle.Render(w, p, opts)
+	}
+
+	return page(w, func(pw io.Writer) error {
+		return puzzle.Render(pw, p, opts)
+	})
+}
+
+// page runs the pager in $PAGER (or less) with its output going to w, and calls render to
+// write the text to page. The text is written to w directly if the pager can't be started.
+func page(w io.Writer, render func(io.Writer) error) error {
+	pager := strings.Fields(os.Getenv("PAGER"))
+	if len(pager) == 0 {
+		pager = strings.Fields(defaultPager)
+	}
+
+	cmd := exec.Command(pager[0], pager[1:]...)
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return render(w)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return render(w)
+	}
+
+	// the pager may be closed before all of the text is written, which isn't an error
+	_ = render(stdin)
+	stdin.Close()
+
+	return cmd.Wait()
+}
+
+// terminalWidth returns the width of the terminal from $COLUMNS, or 80 if it isn't set
+func terminalWidth() int {
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+
+	return 80
+}
+
+// isTerminal returns true if the file is a terminal rather than a file or a pipe
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
@@ -0,0 +1,86 @@
+// submit.go submits the answers of the days to the Advent of Code site, checking them
+// against the answers that have already been submitted
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/trentnix/aoc2024/aoc"
+	"github.com/trentnix/aoc2024/exercise"
+	"github.com/trentnix/aoc2024/runner"
+)
+
+// submitAnswer submits the answer to a part of the registered day and writes the site's
+// verdict to w. An answer that the day's submission history shows is wrong (or that has
+// already been accepted) isn't submitted unless force is set, in which case a warning is
+// written instead. A correct answer is stored as the accepted answer of the day.
+func submitAnswer(ctx context.Context, w io.Writer, client *aoc.Client, registration exercise.Registration, part int, answer string, force bool) (aoc.Verdict, error) {
+	history, err := client.History(registration.Year, registration.Day)
+	if err != nil {
+		return aoc.Verdict{}, err
+	}
+
+	if accepted, found := history.Accepted(part); found && accepted == answer {
+		return aoc.Verdict{}, fmt.Errorf("%s was already accepted as the answer to part %d", answer, part)
+	}
+
+	if err := history.Check(part, answer); err != nil {
+		if !force {
+			return aoc.Verdict{}, err
+		}
+
+		fmt.Fprintln(w, "warning:", err)
+	}
+
+	verdict, err := client.Submit(ctx, registration.Year, registration.Day, part, answer)
+	if err != nil {
+		return verdict, err
+	}
+
+	fmt.Fprintf(w, "%s - Part %d - %s: %s\n", registration.Name(), part, answer, verdict.Outcome)
+	fmt.Fprintln(w, verdict.Message)
+
+	if verdict.Outcome == aoc.Correct {
+		if filename := runner.AnswersFile(registration.Exercise); filename != "" {
+			answers, err := runner.LoadAnswers(filename)
+			if err == nil {
+				answers.SetPart(part, answer)
+				err = runner.SaveAnswers(filename, answers)
+			}
+
+			if err != nil {
+				return verdict, fmt.Errorf("there was an error trying to store the accepted answer: %w", err)
+			}
+		}
+	}
+
+	return verdict, nil
+}
+
+// printHistory writes a note for each answer in the result that the day's submission
+// history shows is wrong, along with the bounds the right answer is known to be within
+func printHistory(w io.Writer, registration exercise.Registration, result exercise.Result) {
+	history, err := aoc.NewClient("").History(registration.Year, registration.Day)
+	if err != nil {
+		fmt.Fprintf(w, "%s - %v\n", registration.Name(), err)
+		return
+	}
+
+	for _, answer := range result.Answers {
+		if answer.Err != nil || answer.String() == "" {
+			continue
+		}
+
+		if err := history.Check(answer.Part, answer.String()); err != nil {
+			fmt.Fprintf(w, "%s - Part %d - %v\n", registration.Name(), answer.Part, err)
+		}
+
+		if bounds := history.Bounds(answer.Part); bounds.HasLow || bounds.HasHigh {
+			if _, found := history.Accepted(answer.Part); !found {
+				fmt.Fprintf(w, "%s - Part %d - the answer is %v\n", registration.Name(), answer.Part, bounds)
+			}
+		}
+	}
+}